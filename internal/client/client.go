@@ -7,8 +7,10 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -21,6 +23,7 @@ type Client struct {
 	apiKey     string
 	httpClient *http.Client
 	baseURL    string
+	workspaces map[string]*Client
 }
 
 func NewClient(apiKey string, customBaseURL string) *Client {
@@ -32,9 +35,58 @@ func NewClient(apiKey string, customBaseURL string) *Client {
 		apiKey:     apiKey,
 		httpClient: &http.Client{},
 		baseURL:    url,
+		workspaces: map[string]*Client{},
 	}
 }
 
+// AddWorkspace registers a client for the named workspace that authenticates
+// with its own API key. Workspace clients share the pool of their parent, so
+// any client can resolve any other configured workspace.
+func (c *Client) AddWorkspace(name string, apiKey string) *Client {
+	wc := &Client{
+		apiKey:     apiKey,
+		httpClient: c.httpClient,
+		baseURL:    c.baseURL,
+		workspaces: c.workspaces,
+	}
+	c.workspaces[name] = wc
+	return wc
+}
+
+// Workspace returns the client for the named workspace. An empty name
+// returns the client itself.
+func (c *Client) Workspace(name string) (*Client, error) {
+	if name == "" {
+		return c, nil
+	}
+
+	wc, ok := c.workspaces[name]
+	if !ok {
+		return nil, fmt.Errorf("workspace %q is not configured in the provider", name)
+	}
+	return wc, nil
+}
+
+// WorkspaceNames returns the names of all configured workspaces in sorted order.
+func (c *Client) WorkspaceNames() []string {
+	names := make([]string, 0, len(c.workspaces))
+	for name := range c.workspaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// APIError is returned for responses with a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("api error (status %d): %s", e.StatusCode, e.Body)
+}
+
 func (c *Client) doRequest(req *http.Request, v interface{}) error {
 	req.Header.Set("xi-api-key", c.apiKey)
 	if req.Header.Get("Content-Type") == "" {
@@ -49,7 +101,12 @@ func (c *Client) doRequest(req *http.Request, v interface{}) error {
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("api error (status %d): %s", resp.StatusCode, string(body))
+		return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	if raw, ok := v.(*[]byte); ok {
+		*raw, err = io.ReadAll(resp.Body)
+		return err
 	}
 
 	if v != nil {
@@ -59,6 +116,18 @@ func (c *Client) doRequest(req *http.Request, v interface{}) error {
 	return nil
 }
 
+// User
+func (c *Client) GetUser() (*models.User, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/user", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user models.User
+	err = c.doRequest(req, &user)
+	return &user, err
+}
+
 // Voices
 func (c *Client) GetVoices() ([]models.Voice, error) {
 	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/voices", nil)
@@ -291,17 +360,22 @@ func (c *Client) AddPronunciationDictionaryFromFile(addReq *models.AddPronunciat
 		_ = writer.WriteField("description", addReq.Description)
 	}
 
-	file, err := os.Open(addReq.FilePath)
+	part, err := writer.CreateFormFile("file", filepath.Base(addReq.FilePath))
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close() //nolint:errcheck
 
-	part, err := writer.CreateFormFile("file", filepath.Base(addReq.FilePath))
-	if err != nil {
-		return nil, err
+	if addReq.Content != nil {
+		_, err = part.Write(addReq.Content)
+	} else {
+		var file *os.File
+		file, err = os.Open(addReq.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close() //nolint:errcheck
+		_, err = io.Copy(part, file)
 	}
-	_, err = io.Copy(part, file)
 	if err != nil {
 		return nil, err
 	}
@@ -380,32 +454,36 @@ func (c *Client) UpdatePronunciationDictionary(dictionaryID string, name string,
 	return c.doRequest(req, nil)
 }
 
-func (c *Client) AddPronunciationDictionaryRules(dictionaryID string, rules []models.PronunciationRule) error {
+func (c *Client) AddPronunciationDictionaryRules(dictionaryID string, rules []models.PronunciationRule) (*models.PronunciationDictionaryRulesResponse, error) {
 	body, err := json.Marshal(map[string][]models.PronunciationRule{"rules": rules})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/pronunciation-dictionaries/"+dictionaryID+"/add-rules", bytes.NewBuffer(body))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return c.doRequest(req, nil)
+	var result models.PronunciationDictionaryRulesResponse
+	err = c.doRequest(req, &result)
+	return &result, err
 }
 
-func (c *Client) RemovePronunciationDictionaryRules(dictionaryID string, rules []models.PronunciationRule) error {
-	body, err := json.Marshal(map[string][]models.PronunciationRule{"rules": rules})
+func (c *Client) RemovePronunciationDictionaryRules(dictionaryID string, ruleStrings []string) (*models.PronunciationDictionaryRulesResponse, error) {
+	body, err := json.Marshal(map[string][]string{"rule_strings": ruleStrings})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/pronunciation-dictionaries/"+dictionaryID+"/remove-rules", bytes.NewBuffer(body))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return c.doRequest(req, nil)
+	var result models.PronunciationDictionaryRulesResponse
+	err = c.doRequest(req, &result)
+	return &result, err
 }
 
 func (c *Client) DownloadPronunciationDictionary(dictionaryID string, versionID string) ([]byte, error) {
@@ -480,57 +558,73 @@ func (c *Client) CreateAudioNative(addReq *models.CreateAudioNativeRequest) (*mo
 	return &project, err
 }
 
-func (c *Client) GetAudioNativeSettings(projectID string) (*models.AudioNativeSettings, error) {
+func (c *Client) GetAudioNativeSettings(projectID string) (*models.AudioNativeProjectSettings, error) {
 	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/audio-native/"+projectID+"/settings", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var settings models.AudioNativeSettings
+	var settings models.AudioNativeProjectSettings
 	err = c.doRequest(req, &settings)
 	return &settings, err
 }
 
-func (c *Client) UpdateAudioNativeContent(projectID string, filePath string, voiceID string, modelID string) error {
+func (c *Client) UpdateAudioNativeContent(projectID string, updateReq *models.UpdateAudioNativeContentRequest) (*models.AudioNativeProject, error) {
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 
-	if voiceID != "" {
-		_ = writer.WriteField("voice_id", voiceID)
+	if updateReq.VoiceID != "" {
+		_ = writer.WriteField("voice_id", updateReq.VoiceID)
 	}
-	if modelID != "" {
-		_ = writer.WriteField("model_id", modelID)
+	if updateReq.ModelID != "" {
+		_ = writer.WriteField("model_id", updateReq.ModelID)
+	}
+	if updateReq.AutoConvert {
+		_ = writer.WriteField("auto_convert", "true")
+	}
+	if updateReq.AutoPublish {
+		_ = writer.WriteField("auto_publish", "true")
 	}
 
-	if filePath != "" {
-		file, err := os.Open(filePath)
+	if updateReq.Content != nil {
+		part, err := writer.CreateFormFile("file", updateReq.FileName)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		defer file.Close() //nolint:errcheck //nolint:errcheck
+		if _, err := part.Write(updateReq.Content); err != nil {
+			return nil, err
+		}
+	} else if updateReq.FilePath != "" {
+		file, err := os.Open(updateReq.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close() //nolint:errcheck
 
-		part, err := writer.CreateFormFile("file", filepath.Base(filePath))
+		part, err := writer.CreateFormFile("file", filepath.Base(updateReq.FilePath))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		_, err = io.Copy(part, file)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	err := writer.Close()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/audio-native/"+projectID+"/content", body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 
-	return c.doRequest(req, nil)
+	var project models.AudioNativeProject
+	err = c.doRequest(req, &project)
+	return &project, err
 }
 
 // Conversational AI Agents
@@ -649,6 +743,35 @@ func (c *Client) DeleteConvAIAgent(agentID string) error {
 	return c.doRequest(req, nil)
 }
 
+// GetConvAIAgentConversationConfig returns the raw conversation_config of an
+// agent, which is not covered by models.ConvAIAgent.
+func (c *Client) GetConvAIAgentConversationConfig(agentID string) (map[string]interface{}, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/convai/agents/"+agentID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var agent struct {
+		ConversationConfig map[string]interface{} `json:"conversation_config"`
+	}
+	err = c.doRequest(req, &agent)
+	return agent.ConversationConfig, err
+}
+
+func (c *Client) UpdateConvAIAgentConversationConfig(agentID string, conversationConfig map[string]interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{"conversation_config": conversationConfig})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, c.baseURL+"/convai/agents/"+agentID, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(req, nil)
+}
+
 func (c *Client) CalculateLLMUsage(agentID string, promptLength int, numberOfPages int, ragEnabled bool) (map[string]interface{}, error) {
 	body := map[string]interface{}{
 		"prompt_length":   promptLength,
@@ -718,24 +841,28 @@ func (c *Client) SimulateConversation(agentID string, chatHistory []map[string]i
 }
 
 // Conversational AI Knowledge Base
-func (c *Client) CreateConvAIKnowledgeBase(addReq *models.CreateConvAIKnowledgeBaseRequest) (*models.ConvAIKnowledgeBase, error) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
 
-	_ = writer.WriteField("name", addReq.Name)
-	if addReq.URL != "" {
-		_ = writer.WriteField("url", addReq.URL)
-	}
-	if addReq.Content != "" {
-		_ = writer.WriteField("content", addReq.Content)
-	}
+// CreateConvAIKnowledgeBase adds a file, URL or text document, in that order
+// of precedence, to the knowledge base.
+func (c *Client) CreateConvAIKnowledgeBase(addReq *models.CreateConvAIKnowledgeBaseRequest) (*models.ConvAIKnowledgeBase, error) {
+	var req *http.Request
+	switch {
+	case addReq.FilePath != "":
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+
+		if addReq.Name != "" {
+			_ = writer.WriteField("name", addReq.Name)
+		}
+		if addReq.ParentFolderID != "" {
+			_ = writer.WriteField("parent_folder_id", addReq.ParentFolderID)
+		}
 
-	if addReq.FilePath != "" {
 		file, err := os.Open(addReq.FilePath)
 		if err != nil {
 			return nil, err
 		}
-		defer file.Close() //nolint:errcheck //nolint:errcheck
+		defer file.Close() //nolint:errcheck
 
 		part, err := writer.CreateFormFile("file", filepath.Base(addReq.FilePath))
 		if err != nil {
@@ -745,26 +872,58 @@ func (c *Client) CreateConvAIKnowledgeBase(addReq *models.CreateConvAIKnowledgeB
 		if err != nil {
 			return nil, err
 		}
-	}
 
-	err := writer.Close()
-	if err != nil {
-		return nil, err
+		err = writer.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		req, err = http.NewRequest(http.MethodPost, c.baseURL+"/convai/knowledge-base/file", body)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	default:
+		endpoint := "/convai/knowledge-base/text"
+		if addReq.URL != "" {
+			endpoint = "/convai/knowledge-base/url"
+		}
+
+		body, err := json.Marshal(addReq)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err = http.NewRequest(http.MethodPost, c.baseURL+endpoint, bytes.NewBuffer(body))
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/convai/knowledge-base", body)
+	var kb models.ConvAIKnowledgeBase
+	err := c.doRequest(req, &kb)
+	return &kb, err
+}
+
+func (c *Client) GetConvAIKnowledgeBase(documentationID string) (*models.ConvAIKnowledgeBase, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/convai/knowledge-base/"+documentationID, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Content-Type", writer.FormDataContentType())
 
 	var kb models.ConvAIKnowledgeBase
 	err = c.doRequest(req, &kb)
 	return &kb, err
 }
 
-func (c *Client) GetConvAIKnowledgeBase(documentationID string) (*models.ConvAIKnowledgeBase, error) {
-	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/convai/knowledge-base/"+documentationID, nil)
+func (c *Client) UpdateConvAIKnowledgeBase(documentationID string, updateReq *models.UpdateConvAIKnowledgeBaseRequest) (*models.ConvAIKnowledgeBase, error) {
+	body, err := json.Marshal(updateReq)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, c.baseURL+"/convai/knowledge-base/"+documentationID, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
@@ -783,6 +942,54 @@ func (c *Client) DeleteConvAIKnowledgeBase(documentationID string) error {
 	return c.doRequest(req, nil)
 }
 
+// GetConvAIKnowledgeBaseContent returns the extracted content of a document.
+func (c *Client) GetConvAIKnowledgeBaseContent(documentationID string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/convai/knowledge-base/"+documentationID+"/content", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var content []byte
+	err = c.doRequest(req, &content)
+	return string(content), err
+}
+
+func (c *Client) GetConvAIKnowledgeBaseChunk(documentationID, chunkID string) (*models.ConvAIKnowledgeBaseChunk, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/convai/knowledge-base/"+documentationID+"/chunk/"+chunkID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var chunk models.ConvAIKnowledgeBaseChunk
+	err = c.doRequest(req, &chunk)
+	return &chunk, err
+}
+
+// GetConvAIKnowledgeBaseSourceFileURL returns a signed URL to download the
+// uploaded file of a file document.
+func (c *Client) GetConvAIKnowledgeBaseSourceFileURL(documentationID string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/convai/knowledge-base/"+documentationID+"/source-file-url", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		SignedURL string `json:"signed_url"`
+	}
+	err = c.doRequest(req, &result)
+	return result.SignedURL, err
+}
+
+// GetConvAIKnowledgeBaseDependentAgents returns the agents that use a
+// document. dependentType is direct, transitive or all; empty means all.
+func (c *Client) GetConvAIKnowledgeBaseDependentAgents(documentationID, dependentType string) ([]models.ConvAIDependentAgent, error) {
+	query := url.Values{}
+	if dependentType != "" {
+		query.Set("dependent_type", dependentType)
+	}
+	return c.getConvAIDependentAgents("/convai/knowledge-base/"+documentationID+"/dependent-agents", query)
+}
+
 func (c *Client) ListConvAIKnowledgeBaseDocuments(params *models.ListConvAIKnowledgeBaseDocumentsParams) (*models.ConvAIKnowledgeBaseListResponse, error) {
 	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/convai/knowledge-base", nil)
 	if err != nil {
@@ -808,6 +1015,9 @@ func (c *Client) ListConvAIKnowledgeBaseDocuments(params *models.ListConvAIKnowl
 				query.Add("types", t)
 			}
 		}
+		if params.ParentFolderID != "" {
+			query.Set("parent_folder_id", params.ParentFolderID)
+		}
 		req.URL.RawQuery = query.Encode()
 	}
 
@@ -843,6 +1053,30 @@ func (c *Client) GetConvAIKnowledgeBaseRAGIndexes(documentationID string) (*mode
 	return &response, err
 }
 
+// GetConvAIRAGIndexOverview returns the RAG index storage used by the
+// workspace and its limit.
+func (c *Client) GetConvAIRAGIndexOverview() (*models.RAGIndexOverviewResponse, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/convai/knowledge-base/rag-index", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var overview models.RAGIndexOverviewResponse
+	err = c.doRequest(req, &overview)
+	return &overview, err
+}
+
+func (c *Client) GetConvAIAgentKnowledgeBaseSize(agentID string) (*models.ConvAIAgentKnowledgeBaseSize, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/convai/agent/"+agentID+"/knowledge-base/size", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var size models.ConvAIAgentKnowledgeBaseSize
+	err = c.doRequest(req, &size)
+	return &size, err
+}
+
 func (c *Client) DeleteConvAIKnowledgeBaseRAGIndex(documentationID, ragIndexID string) error {
 	req, err := http.NewRequest(http.MethodDelete, c.baseURL+"/convai/knowledge-base/"+documentationID+"/rag-index/"+ragIndexID, nil)
 	if err != nil {
@@ -914,6 +1148,36 @@ func (c *Client) GetConvAITools() ([]models.ConvAITool, error) {
 	return resp.Tools, err
 }
 
+// GetConvAIToolDependentAgents returns every agent that uses the tool.
+func (c *Client) GetConvAIToolDependentAgents(toolID string) ([]models.ConvAIDependentAgent, error) {
+	return c.getConvAIDependentAgents("/convai/tools/"+toolID+"/dependent-agents", url.Values{})
+}
+
+// getConvAIDependentAgents reads a dependent-agents endpoint, following the
+// cursor until all pages are read.
+func (c *Client) getConvAIDependentAgents(path string, query url.Values) ([]models.ConvAIDependentAgent, error) {
+	var agents []models.ConvAIDependentAgent
+	query.Set("page_size", "100")
+	for {
+		req, err := http.NewRequest(http.MethodGet, c.baseURL+path, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.URL.RawQuery = query.Encode()
+
+		var page models.ConvAIDependentAgentsResponse
+		if err := c.doRequest(req, &page); err != nil {
+			return nil, err
+		}
+		agents = append(agents, page.Agents...)
+
+		if !page.HasMore || page.NextCursor == "" || page.NextCursor == query.Get("cursor") {
+			return agents, nil
+		}
+		query.Set("cursor", page.NextCursor)
+	}
+}
+
 // Conversational AI Secrets
 func (c *Client) CreateConvAISecret(addReq *models.CreateConvAISecretRequest) (*models.ConvAISecret, error) {
 	body, err := json.Marshal(addReq)
@@ -993,6 +1257,40 @@ func (c *Client) GetConvAIAgentTest(testID string) (*models.ConvAIAgentTest, err
 	return &test, err
 }
 
+func (c *Client) UpdateConvAIAgentTest(testID string, updateReq *models.UpdateConvAIAgentTestRequest) (*models.ConvAIAgentTest, error) {
+	body, err := json.Marshal(updateReq)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, c.baseURL+"/convai/agent-testing/"+testID, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var test models.ConvAIAgentTest
+	err = c.doRequest(req, &test)
+	return &test, err
+}
+
+// GetConvAIAgentTestSummaries returns the summaries of the given tests, keyed
+// by test ID. Unknown IDs are left out.
+func (c *Client) GetConvAIAgentTestSummaries(testIDs []string) (map[string]models.ConvAIAgentTestSummary, error) {
+	body, err := json.Marshal(map[string][]string{"test_ids": testIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/convai/agent-testing/summaries", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ConvAIAgentTestSummariesResponse
+	err = c.doRequest(req, &response)
+	return response.Tests, err
+}
+
 func (c *Client) DeleteConvAIAgentTest(testID string) error {
 	req, err := http.NewRequest(http.MethodDelete, c.baseURL+"/convai/agent-testing/"+testID, nil)
 	if err != nil {
@@ -1308,7 +1606,7 @@ func (c *Client) GetConvAIConversations() ([]map[string]interface{}, error) {
 	return conversations, err
 }
 
-func (c *Client) GetConvAISignedUrl(agentID string, includeConversationID bool) (string, string, error) {
+func (c *Client) GetConvAISignedUrl(agentID string, includeConversationID bool) (*models.ConvAISignedURL, error) {
 	url := c.baseURL + "/convai/conversation/get-signed-url?agent_id=" + agentID
 	if includeConversationID {
 		url += "&include_conversation_id=true"
@@ -1316,15 +1614,46 @@ func (c *Client) GetConvAISignedUrl(agentID string, includeConversationID bool)
 
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
-		return "", "", err
+		return nil, err
+	}
+
+	var signedURL models.ConvAISignedURL
+	err = c.doRequest(req, &signedURL)
+	return &signedURL, err
+}
+
+func (c *Client) GetConvAIConversationToken(agentID string, participantName string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/convai/conversation/token", nil)
+	if err != nil {
+		return "", err
 	}
 
+	query := req.URL.Query()
+	query.Set("agent_id", agentID)
+	if participantName != "" {
+		query.Set("participant_name", participantName)
+	}
+	req.URL.RawQuery = query.Encode()
+
 	var response struct {
-		ConversationSignature string `json:"conversation_signature"`
-		ConversationID        string `json:"conversation_id,omitempty"`
+		Token string `json:"token"`
 	}
 	err = c.doRequest(req, &response)
-	return response.ConversationSignature, response.ConversationID, err
+	return response.Token, err
+}
+
+// Single Use Tokens
+func (c *Client) CreateSingleUseToken(tokenType string) (string, error) {
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/single-use-token/"+tokenType, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		Token string `json:"token"`
+	}
+	err = c.doRequest(req, &response)
+	return response.Token, err
 }
 
 // Dubbing
@@ -1418,15 +1747,15 @@ func (c *Client) CancelConvAIBatchCall(batchID string) error {
 }
 
 // Workspace Webhooks
-func (c *Client) ListWorkspaceWebhooks() ([]models.WorkspaceWebhook, error) {
-	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/workspace/webhooks", nil)
+func (c *Client) ListWorkspaceWebhooks(includeUsages bool) ([]models.WorkspaceWebhook, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/workspace/webhooks?include_usages="+strconv.FormatBool(includeUsages), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var webhooks []models.WorkspaceWebhook
+	var webhooks models.WorkspaceWebhooksResponse
 	err = c.doRequest(req, &webhooks)
-	return webhooks, err
+	return webhooks.Webhooks, err
 }
 
 func (c *Client) CreateWorkspaceWebhook(addReq *models.CreateWorkspaceWebhookRequest) (*models.WorkspaceWebhook, error) {
@@ -1456,7 +1785,7 @@ func (c *Client) GetWorkspaceWebhook(webhookID string) (*models.WorkspaceWebhook
 	return &webhook, err
 }
 
-func (c *Client) UpdateWorkspaceWebhook(webhookID string, updateReq *models.CreateWorkspaceWebhookRequest) error {
+func (c *Client) UpdateWorkspaceWebhook(webhookID string, updateReq *models.UpdateWorkspaceWebhookRequest) error {
 	body, err := json.Marshal(updateReq)
 	if err != nil {
 		return err
@@ -1505,6 +1834,20 @@ func (c *Client) UpdateWorkspaceMember(userID string, updateReq *models.UpdateWo
 	return c.doRequest(req, nil)
 }
 
+func (c *Client) UpdateWorkspaceMemberAccess(updateReq *models.UpdateWorkspaceMemberAccessRequest) error {
+	body, err := json.Marshal(updateReq)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/workspace/members", bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(req, nil)
+}
+
 // Workspace Invites
 func (c *Client) GetWorkspaceInvites() ([]models.WorkspaceInvite, error) {
 	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/workspace/invites", nil)
@@ -1531,6 +1874,20 @@ func (c *Client) CreateWorkspaceInvite(addReq *models.CreateWorkspaceInviteReque
 	return c.doRequest(req, nil)
 }
 
+func (c *Client) CreateWorkspaceInvitesBulk(addReq *models.CreateWorkspaceInvitesBulkRequest) error {
+	body, err := json.Marshal(addReq)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/workspace/invites/add-bulk", bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(req, nil)
+}
+
 func (c *Client) DeleteWorkspaceInvite(email string) error {
 	body := map[string]string{"email": email}
 	jsonBody, _ := json.Marshal(body)
@@ -1544,8 +1901,8 @@ func (c *Client) DeleteWorkspaceInvite(email string) error {
 }
 
 // Workspace Groups
-func (c *Client) SearchWorkspaceGroups(query string) ([]models.WorkspaceGroup, error) {
-	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/workspace/groups/search?search="+query, nil)
+func (c *Client) SearchWorkspaceGroups(name string) ([]models.WorkspaceGroup, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/workspace/groups/search?name="+url.QueryEscape(name), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1597,9 +1954,9 @@ func (c *Client) GetServiceAccountAPIKeys(userID string) ([]models.ServiceAccoun
 		return nil, err
 	}
 
-	var keys []models.ServiceAccountKey
+	var keys models.ServiceAccountKeysResponse
 	err = c.doRequest(req, &keys)
-	return keys, err
+	return keys.APIKeys, err
 }
 
 func (c *Client) CreateServiceAccountKey(userID string, addReq *models.CreateServiceAccountKeyRequest) (*models.ServiceAccountKey, error) {
@@ -1618,6 +1975,20 @@ func (c *Client) CreateServiceAccountKey(userID string, addReq *models.CreateSer
 	return &key, err
 }
 
+func (c *Client) UpdateServiceAccountKey(userID, keyID string, updateReq *models.UpdateServiceAccountKeyRequest) error {
+	body, err := json.Marshal(updateReq)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, c.baseURL+"/service-accounts/"+userID+"/api-keys/"+keyID, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(req, nil)
+}
+
 func (c *Client) DeleteServiceAccountKey(userID, keyID string) error {
 	req, err := http.NewRequest(http.MethodDelete, c.baseURL+"/service-accounts/"+userID+"/api-keys/"+keyID, nil)
 	if err != nil {
@@ -1639,8 +2010,8 @@ func (c *Client) GetWorkspaceResources() ([]models.WorkspaceResource, error) {
 	return resources, err
 }
 
-func (c *Client) GetWorkspaceResource(resourceID string) (*models.WorkspaceResource, error) {
-	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/workspace/resources/"+resourceID, nil)
+func (c *Client) GetWorkspaceResource(resourceID, resourceType string) (*models.WorkspaceResource, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/workspace/resources/"+resourceID+"?resource_type="+url.QueryEscape(resourceType), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1650,13 +2021,8 @@ func (c *Client) GetWorkspaceResource(resourceID string) (*models.WorkspaceResou
 	return &resource, err
 }
 
-func (c *Client) ShareResource(resourceID, resourceType, email, role string) error {
-	body := map[string]interface{}{
-		"email":         email,
-		"resource_type": resourceType,
-		"role":          role,
-	}
-	jsonBody, _ := json.Marshal(body)
+func (c *Client) ShareResource(resourceID string, shareReq *models.ShareWorkspaceResourceRequest) error {
+	jsonBody, _ := json.Marshal(shareReq)
 
 	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/workspace/resources/"+resourceID+"/share", bytes.NewBuffer(jsonBody))
 	if err != nil {
@@ -1666,11 +2032,10 @@ func (c *Client) ShareResource(resourceID, resourceType, email, role string) err
 	return c.doRequest(req, nil)
 }
 
-func (c *Client) UnshareResource(resourceID, resourceType, email string) error {
-	body := map[string]interface{}{
-		"email":         email,
-		"resource_type": resourceType,
-	}
+// UnshareResource removes the access of the principal in unshareReq. Role is ignored.
+func (c *Client) UnshareResource(resourceID string, unshareReq *models.ShareWorkspaceResourceRequest) error {
+	body := *unshareReq
+	body.Role = ""
 	jsonBody, _ := json.Marshal(body)
 
 	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/workspace/resources/"+resourceID+"/unshare", bytes.NewBuffer(jsonBody))
@@ -1681,12 +2046,8 @@ func (c *Client) UnshareResource(resourceID, resourceType, email string) error {
 	return c.doRequest(req, nil)
 }
 
-func (c *Client) CopyResourceToWorkspace(resourceID, resourceType, targetWorkspaceID string) error {
-	body := map[string]interface{}{
-		"resource_type":       resourceType,
-		"target_workspace_id": targetWorkspaceID,
-	}
-	jsonBody, _ := json.Marshal(body)
+func (c *Client) CopyResourceToWorkspace(resourceID string, copyReq *models.CopyWorkspaceResourceRequest) error {
+	jsonBody, _ := json.Marshal(copyReq)
 
 	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/workspace/resources/"+resourceID+"/copy-to-workspace", bytes.NewBuffer(jsonBody))
 	if err != nil {
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_Workspace(t *testing.T) {
+	var gotKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = r.Header.Get("xi-api-key")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"voice_id": "voice-123"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("default-key", server.URL)
+	client.AddWorkspace("shared", "shared-key")
+	client.AddWorkspace("production", "production-key")
+
+	shared, err := client.Workspace("shared")
+	if err != nil {
+		t.Fatalf("Workspace failed: %v", err)
+	}
+	if _, err := shared.GetVoice("voice-123"); err != nil {
+		t.Fatalf("GetVoice failed: %v", err)
+	}
+	if gotKey != "shared-key" {
+		t.Errorf("Expected api key 'shared-key', got '%s'", gotKey)
+	}
+
+	production, err := shared.Workspace("production")
+	if err != nil {
+		t.Fatalf("Workspace failed: %v", err)
+	}
+	if _, err := production.GetVoice("voice-123"); err != nil {
+		t.Fatalf("GetVoice failed: %v", err)
+	}
+	if gotKey != "production-key" {
+		t.Errorf("Expected api key 'production-key', got '%s'", gotKey)
+	}
+
+	defaultClient, err := client.Workspace("")
+	if err != nil {
+		t.Fatalf("Workspace failed: %v", err)
+	}
+	if defaultClient != client {
+		t.Errorf("Expected empty workspace name to return the default client")
+	}
+
+	if _, err := client.Workspace("missing"); err == nil {
+		t.Errorf("Expected error for unconfigured workspace")
+	}
+}
+
+func TestClient_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"detail":"voice not found"}`, http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := NewClient("test-key", server.URL).GetVoice("voice-123")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", apiErr.StatusCode)
+	}
+	if !strings.Contains(err.Error(), "api error (status 404)") {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+}
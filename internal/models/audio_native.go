@@ -2,14 +2,23 @@ package models
 
 type AudioNativeProject struct {
 	ProjectID   string `json:"project_id"`
+	Converting  bool   `json:"converting"`
+	Publishing  bool   `json:"publishing,omitempty"`
 	HTMLSnippet string `json:"html_snippet,omitempty"`
 }
 
+type AudioNativeProjectSettings struct {
+	Enabled    bool                 `json:"enabled"`
+	SnapshotID string               `json:"snapshot_id,omitempty"`
+	Settings   *AudioNativeSettings `json:"settings,omitempty"`
+}
+
 type AudioNativeSettings struct {
 	Title           string `json:"title"`
 	Author          string `json:"author"`
 	TextColor       string `json:"text_color"`
 	BackgroundColor string `json:"background_color"`
+	AudioURL        string `json:"audio_url,omitempty"`
 	Status          string `json:"status"`
 }
 
@@ -26,3 +35,13 @@ type CreateAudioNativeRequest struct {
 	PronunciationDictionaryIDs []string `json:"pronunciation_dictionary_locators,omitempty"`
 	FilePath                   string   `json:"-"`
 }
+
+type UpdateAudioNativeContentRequest struct {
+	FilePath    string
+	Content     []byte
+	FileName    string
+	VoiceID     string
+	ModelID     string
+	AutoConvert bool
+	AutoPublish bool
+}
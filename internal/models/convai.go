@@ -19,10 +19,11 @@ type CreateConvAIAgentRequest struct {
 }
 
 type ConvAIKnowledgeBase struct {
-	DocumentationID string                                 `json:"documentation_id"`
+	DocumentationID string                                 `json:"id"`
 	Name            string                                 `json:"name"`
 	Type            string                                 `json:"type"`
 	Status          string                                 `json:"status"`
+	URL             string                                 `json:"url,omitempty"`
 	Metadata        map[string]interface{}                 `json:"metadata,omitempty"`
 	SupportedUsages []string                               `json:"supported_usages,omitempty"`
 	FolderParentID  string                                 `json:"folder_parent_id,omitempty"`
@@ -31,18 +32,24 @@ type ConvAIKnowledgeBase struct {
 }
 
 type CreateConvAIKnowledgeBaseRequest struct {
-	Name     string `json:"name"`
-	URL      string `json:"url,omitempty"`
-	Content  string `json:"content,omitempty"`
-	FilePath string `json:"-"`
+	Name           string `json:"name,omitempty"`
+	URL            string `json:"url,omitempty"`
+	Content        string `json:"text,omitempty"`
+	FilePath       string `json:"-"`
+	ParentFolderID string `json:"parent_folder_id,omitempty"`
+}
+
+type UpdateConvAIKnowledgeBaseRequest struct {
+	Name string `json:"name"`
 }
 
 type ListConvAIKnowledgeBaseDocumentsParams struct {
-	PageSize      *int
-	Search        string
-	Cursor        string
-	ShowOnlyOwned *bool
-	Types         []string
+	PageSize       *int
+	Search         string
+	Cursor         string
+	ShowOnlyOwned  *bool
+	Types          []string
+	ParentFolderID string
 }
 
 type ConvAIKnowledgeBaseListResponse struct {
@@ -51,26 +58,95 @@ type ConvAIKnowledgeBaseListResponse struct {
 	NextCursor string                `json:"next_cursor"`
 }
 
+type ConvAIAgentKnowledgeBaseSize struct {
+	NumberOfPages float64 `json:"number_of_pages"`
+}
+
+type ConvAIKnowledgeBaseChunk struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
 type ConvAIKnowledgeBaseFolderPathSegment struct {
-	FolderID   string `json:"folder_id"`
-	FolderName string `json:"folder_name"`
+	FolderID   string `json:"id"`
+	FolderName string `json:"name"`
 }
 
 type ConvAITool struct {
-	ToolID            string                 `json:"tool_id"`
-	Name              string                 `json:"name"`
-	Description       string                 `json:"description"`
-	Parameters        map[string]interface{} `json:"parameters"`
+	ToolID            string                 `json:"id"`
+	ToolConfig        ConvAIToolConfig       `json:"tool_config"`
 	DependentAgentIDs []string               `json:"dependent_agent_ids,omitempty"`
 	AccessInfo        map[string]interface{} `json:"access_info,omitempty"`
-	ToolConfig        map[string]interface{} `json:"tool_config,omitempty"`
 	UsageStats        map[string]interface{} `json:"usage_stats,omitempty"`
 }
 
+// ConvAIToolConfig is the configuration of a webhook, client or system tool.
+// Type selects which of the type-specific fields apply.
+type ConvAIToolConfig struct {
+	Type                  string                      `json:"type"`
+	Name                  string                      `json:"name"`
+	Description           string                      `json:"description"`
+	ResponseTimeoutSecs   *int64                      `json:"response_timeout_secs,omitempty"`
+	DisableInterruptions  *bool                       `json:"disable_interruptions,omitempty"`
+	ForcePreToolSpeech    *bool                       `json:"force_pre_tool_speech,omitempty"`
+	ToolCallSound         *string                     `json:"tool_call_sound,omitempty"`
+	ToolCallSoundBehavior *string                     `json:"tool_call_sound_behavior,omitempty"`
+	ExecutionMode         *string                     `json:"execution_mode,omitempty"`
+	Assignments           []DynamicVariableAssignment `json:"assignments,omitempty"`
+
+	// Webhook tools.
+	APISchema *ConvAIWebhookToolAPISchema `json:"api_schema,omitempty"`
+
+	// Client tools.
+	Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	ExpectsResponse *bool                  `json:"expects_response,omitempty"`
+
+	// System tools.
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+type ConvAIWebhookToolAPISchema struct {
+	URL    string `json:"url"`
+	Method string `json:"method,omitempty"`
+	// RequestHeaders values are either a string, a ConvAISecretLocator or a
+	// ConvAIDynamicVariable.
+	RequestHeaders    map[string]interface{}                     `json:"request_headers,omitempty"`
+	PathParamsSchema  map[string]ConvAILiteralJSONSchemaProperty `json:"path_params_schema,omitempty"`
+	QueryParamsSchema *ConvAIQueryParamsJSONSchema               `json:"query_params_schema,omitempty"`
+	RequestBodySchema map[string]interface{}                     `json:"request_body_schema,omitempty"`
+	ContentType       string                                     `json:"content_type,omitempty"`
+}
+
+type ConvAISecretLocator struct {
+	SecretID string `json:"secret_id"`
+}
+
+type ConvAIDynamicVariable struct {
+	VariableName string `json:"variable_name"`
+}
+
+type ConvAILiteralJSONSchemaProperty struct {
+	Type            string      `json:"type"`
+	Description     string      `json:"description,omitempty"`
+	Enum            []string    `json:"enum,omitempty"`
+	DynamicVariable string      `json:"dynamic_variable,omitempty"`
+	ConstantValue   interface{} `json:"constant_value,omitempty"`
+}
+
+type ConvAIQueryParamsJSONSchema struct {
+	Properties map[string]ConvAILiteralJSONSchemaProperty `json:"properties"`
+	Required   []string                                   `json:"required,omitempty"`
+}
+
 type CreateConvAIToolRequest struct {
-	Name        string                 `json:"name"`
-	Description string                 `json:"description"`
-	Parameters  map[string]interface{} `json:"parameters"`
+	ToolConfig ConvAIToolConfig `json:"tool_config"`
+}
+
+type ConvAISignedURL struct {
+	SignedURL             string `json:"signed_url"`
+	ConversationSignature string `json:"conversation_signature,omitempty"`
+	ConversationID        string `json:"conversation_id,omitempty"`
 }
 
 type ConvAISecret struct {
@@ -83,24 +159,105 @@ type CreateConvAISecretRequest struct {
 	Value string `json:"value"`
 }
 
+// ConvAIAgentTest is a test that checks an agent's next response, or the tool
+// it calls, after a fixed chat history.
 type ConvAIAgentTest struct {
-	TestID           string `json:"test_id"`
-	Name             string `json:"name"`
-	SuccessCondition string `json:"success_condition"`
+	TestID             string                             `json:"id"`
+	Name               string                             `json:"name"`
+	Type               string                             `json:"type,omitempty"`
+	ChatHistory        []ConvAIAgentTestChatMessage       `json:"chat_history"`
+	SuccessCondition   string                             `json:"success_condition"`
+	SuccessExamples    []ConvAIAgentTestResponseExample   `json:"success_examples"`
+	FailureExamples    []ConvAIAgentTestResponseExample   `json:"failure_examples"`
+	ToolCallParameters *ConvAIAgentTestToolCallEvaluation `json:"tool_call_parameters,omitempty"`
+	DynamicVariables   map[string]interface{}             `json:"dynamic_variables,omitempty"`
+}
+
+type ConvAIAgentTestChatMessage struct {
+	Role           string  `json:"role"`
+	Message        *string `json:"message,omitempty"`
+	TimeInCallSecs int64   `json:"time_in_call_secs"`
+}
+
+// ConvAIAgentTestResponseExample is an example response. Type is "success" or
+// "failure".
+type ConvAIAgentTestResponseExample struct {
+	Response string `json:"response"`
+	Type     string `json:"type"`
+}
+
+type ConvAIAgentTestToolCallEvaluation struct {
+	Parameters     []ConvAIAgentTestToolCallParameter `json:"parameters"`
+	ReferencedTool *ConvAIReferencedTool              `json:"referenced_tool,omitempty"`
+	VerifyAbsence  bool                               `json:"verify_absence"`
+}
+
+type ConvAIReferencedTool struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type ConvAIAgentTestToolCallParameter struct {
+	Path string                       `json:"path"`
+	Eval ConvAIAgentTestParameterEval `json:"eval"`
+}
+
+// ConvAIAgentTestParameterEval checks a tool call parameter. Type is "exact",
+// "regex", "llm" or "anything" and selects which other field applies.
+type ConvAIAgentTestParameterEval struct {
+	Type          string `json:"type"`
+	ExpectedValue string `json:"expected_value,omitempty"`
+	Pattern       string `json:"pattern,omitempty"`
+	Description   string `json:"description,omitempty"`
 }
 
 type CreateConvAIAgentTestRequest struct {
-	Name             string        `json:"name"`
-	ChatHistory      []interface{} `json:"chat_history"`
-	SuccessCondition string        `json:"success_condition"`
-	SuccessExamples  []string      `json:"success_examples"`
-	FailureExamples  []string      `json:"failure_examples"`
+	Name               string                             `json:"name"`
+	Type               string                             `json:"type,omitempty"`
+	ChatHistory        []ConvAIAgentTestChatMessage       `json:"chat_history"`
+	SuccessCondition   string                             `json:"success_condition"`
+	SuccessExamples    []ConvAIAgentTestResponseExample   `json:"success_examples"`
+	FailureExamples    []ConvAIAgentTestResponseExample   `json:"failure_examples"`
+	ToolCallParameters *ConvAIAgentTestToolCallEvaluation `json:"tool_call_parameters,omitempty"`
+	DynamicVariables   map[string]interface{}             `json:"dynamic_variables,omitempty"`
+}
+
+// UpdateConvAIAgentTestRequest replaces the whole test definition.
+type UpdateConvAIAgentTestRequest = CreateConvAIAgentTestRequest
+
+type ConvAIAgentTestSummary struct {
+	ID                    string `json:"id"`
+	Name                  string `json:"name"`
+	Type                  string `json:"type"`
+	CreatedAtUnixSecs     int64  `json:"created_at_unix_secs"`
+	LastUpdatedAtUnixSecs int64  `json:"last_updated_at_unix_secs"`
+}
+
+type ConvAIAgentTestSummariesResponse struct {
+	Tests map[string]ConvAIAgentTestSummary `json:"tests"`
 }
 
 type ConvAIToolsResponse struct {
 	Tools []ConvAITool `json:"tools"`
 }
 
+// ConvAIDependentAgent is an agent that uses a tool or knowledge base. Agents
+// the caller cannot access have type "unknown" and no ID.
+type ConvAIDependentAgent struct {
+	ID                    string   `json:"id,omitempty"`
+	Name                  string   `json:"name,omitempty"`
+	Type                  string   `json:"type"`
+	CreatedAtUnixSecs     int64    `json:"created_at_unix_secs,omitempty"`
+	AccessLevel           string   `json:"access_level,omitempty"`
+	ReferencedResourceIDs []string `json:"referenced_resource_ids,omitempty"`
+}
+
+type ConvAIDependentAgentsResponse struct {
+	Agents     []ConvAIDependentAgent `json:"agents"`
+	HasMore    bool                   `json:"has_more"`
+	NextCursor string                 `json:"next_cursor"`
+}
+
 type ConvAIWhatsAppAccount struct {
 	BusinessAccountID   string `json:"business_account_id"`
 	BusinessAccountName string `json:"business_account_name"`
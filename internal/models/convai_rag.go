@@ -19,3 +19,14 @@ type RAGDocumentIndexResponse struct {
 type RAGDocumentIndexesResponse struct {
 	Indexes []RAGDocumentIndexResponse `json:"indexes"`
 }
+
+type RAGIndexOverviewModelUsage struct {
+	Model     string `json:"model"`
+	UsedBytes int64  `json:"used_bytes"`
+}
+
+type RAGIndexOverviewResponse struct {
+	TotalUsedBytes int64                        `json:"total_used_bytes"`
+	TotalMaxBytes  int64                        `json:"total_max_bytes"`
+	Models         []RAGIndexOverviewModelUsage `json:"models"`
+}
@@ -5,15 +5,23 @@ type WorkspaceMember struct {
 	Email               string `json:"email"`
 	WorkspacePermission string `json:"workspace_permission"`
 	IsInvited           bool   `json:"is_invited"`
+	IsLocked            bool   `json:"is_locked"`
 }
 
 type UpdateWorkspaceMemberRequest struct {
 	WorkspacePermission string `json:"workspace_permission"`
 }
 
+type UpdateWorkspaceMemberAccessRequest struct {
+	Email         string `json:"email"`
+	IsLocked      *bool  `json:"is_locked,omitempty"`
+	WorkspaceRole string `json:"workspace_role,omitempty"`
+}
+
 type WorkspaceGroup struct {
-	GroupID string `json:"group_id"`
-	Name    string `json:"name"`
+	GroupID       string   `json:"id"`
+	Name          string   `json:"name"`
+	MembersEmails []string `json:"members_emails"`
 }
 
 type CreateWorkspaceGroupRequest struct {
@@ -1,12 +1,13 @@
 package models
 
 type PronunciationDictionary struct {
-	ID               string `json:"id"`
-	LatestVersionID  string `json:"latest_version_id"`
-	Name             string `json:"name"`
-	CreatedBy        string `json:"created_by"`
-	CreationTimeUnix int64  `json:"creation_time_unix"`
-	ArchivedTimeUnix int64  `json:"archived_time_unix,omitempty"`
+	ID                    string `json:"id"`
+	LatestVersionID       string `json:"latest_version_id"`
+	LatestVersionRulesNum int    `json:"latest_version_rules_num"`
+	Name                  string `json:"name"`
+	CreatedBy             string `json:"created_by"`
+	CreationTimeUnix      int64  `json:"creation_time_unix"`
+	ArchivedTimeUnix      int64  `json:"archived_time_unix,omitempty"`
 }
 
 type PronunciationRule struct {
@@ -17,6 +18,12 @@ type PronunciationRule struct {
 	Alphabet        string `json:"alphabet,omitempty"`
 }
 
+type PronunciationDictionaryRulesResponse struct {
+	ID              string `json:"id"`
+	VersionID       string `json:"version_id"`
+	VersionRulesNum int    `json:"version_rules_num"`
+}
+
 type AddPronunciationDictionaryFromRulesRequest struct {
 	Name        string              `json:"name"`
 	Description string              `json:"description,omitempty"`
@@ -27,4 +34,6 @@ type AddPronunciationDictionaryFromFileRequest struct {
 	Name        string `json:"name"`
 	Description string `json:"description,omitempty"`
 	FilePath    string `json:"-"`
+	// Content, when set, is uploaded as the PLS file instead of reading FilePath.
+	Content []byte `json:"-"`
 }
@@ -0,0 +1,15 @@
+package models
+
+type UserSubscription struct {
+	Tier           string `json:"tier"`
+	CharacterCount int    `json:"character_count"`
+	CharacterLimit int    `json:"character_limit"`
+	Status         string `json:"status"`
+}
+
+type User struct {
+	UserID          string           `json:"user_id"`
+	FirstName       string           `json:"first_name,omitempty"`
+	XiAPIKeyPreview string           `json:"xi_api_key_preview,omitempty"`
+	Subscription    UserSubscription `json:"subscription"`
+}
@@ -1,15 +1,47 @@
 package models
 
 type WorkspaceWebhook struct {
-	WebhookID string   `json:"webhook_id"`
-	URL       string   `json:"url"`
-	Events    []string `json:"events"`
-	Secret    string   `json:"secret,omitempty"`
+	WebhookID                  string                  `json:"webhook_id"`
+	Name                       string                  `json:"name,omitempty"`
+	URL                        string                  `json:"url"`
+	WebhookURL                 string                  `json:"webhook_url,omitempty"`
+	Events                     []string                `json:"events"`
+	Secret                     string                  `json:"secret,omitempty"`
+	WebhookSecret              string                  `json:"webhook_secret,omitempty"`
+	AuthType                   string                  `json:"auth_type,omitempty"`
+	IsDisabled                 bool                    `json:"is_disabled"`
+	IsAutoDisabled             bool                    `json:"is_auto_disabled"`
+	CreatedAtUnix              int64                   `json:"created_at_unix,omitempty"`
+	Usage                      []WorkspaceWebhookUsage `json:"usage,omitempty"`
+	MostRecentFailureErrorCode *int                    `json:"most_recent_failure_error_code,omitempty"`
+	MostRecentFailureTimestamp *int64                  `json:"most_recent_failure_timestamp,omitempty"`
+}
+
+type WorkspaceWebhookUsage struct {
+	UsageType string `json:"usage_type"`
+}
+
+type WorkspaceWebhooksResponse struct {
+	Webhooks []WorkspaceWebhook `json:"webhooks"`
+}
+
+type WorkspaceWebhookSettings struct {
+	AuthType   string `json:"auth_type"`
+	Name       string `json:"name"`
+	WebhookURL string `json:"webhook_url"`
 }
 
 type CreateWorkspaceWebhookRequest struct {
-	URL    string   `json:"url"`
-	Events []string `json:"events"`
+	URL      string                    `json:"url"`
+	Events   []string                  `json:"events"`
+	Settings *WorkspaceWebhookSettings `json:"settings,omitempty"`
+}
+
+type UpdateWorkspaceWebhookRequest struct {
+	URL        string   `json:"url,omitempty"`
+	Events     []string `json:"events,omitempty"`
+	Name       string   `json:"name"`
+	IsDisabled bool     `json:"is_disabled"`
 }
 
 type WorkspaceInvite struct {
@@ -18,15 +50,31 @@ type WorkspaceInvite struct {
 }
 
 type CreateWorkspaceInviteRequest struct {
-	Email               string `json:"email"`
-	WorkspacePermission string `json:"workspace_permission"`
+	Email               string   `json:"email"`
+	WorkspacePermission string   `json:"workspace_permission,omitempty"`
+	GroupIDs            []string `json:"group_ids,omitempty"`
+}
+
+type CreateWorkspaceInvitesBulkRequest struct {
+	Emails   []string `json:"emails"`
+	GroupIDs []string `json:"group_ids,omitempty"`
 }
 
 type ServiceAccountKey struct {
-	KeyID       string   `json:"key_id"`
-	XiApiKey    string   `json:"xi-api-key,omitempty"`
-	Name        string   `json:"name"`
-	Permissions []string `json:"permissions"`
+	KeyID                string   `json:"key_id"`
+	XiApiKey             string   `json:"xi-api-key,omitempty"`
+	Name                 string   `json:"name"`
+	Hint                 string   `json:"hint,omitempty"`
+	ServiceAccountUserID string   `json:"service_account_user_id,omitempty"`
+	Permissions          []string `json:"permissions"`
+	IsDisabled           bool     `json:"is_disabled"`
+	CharacterLimit       *int     `json:"character_limit,omitempty"`
+	CharacterCount       *int     `json:"character_count,omitempty"`
+	CreatedAtUnix        int64    `json:"created_at_unix,omitempty"`
+}
+
+type ServiceAccountKeysResponse struct {
+	APIKeys []ServiceAccountKey `json:"api-keys"`
 }
 
 type CreateServiceAccountKeyRequest struct {
@@ -35,9 +83,17 @@ type CreateServiceAccountKeyRequest struct {
 	CharacterLimit int      `json:"character_limit,omitempty"`
 }
 
+type UpdateServiceAccountKeyRequest struct {
+	IsEnabled      bool     `json:"is_enabled"`
+	Name           string   `json:"name"`
+	Permissions    []string `json:"permissions"`
+	CharacterLimit *int     `json:"character_limit"`
+}
+
 type WorkspaceServiceAccount struct {
-	ServiceAccountUserID string `json:"service_account_user_id"`
-	Name                 string `json:"name"`
+	ServiceAccountUserID string              `json:"service_account_user_id"`
+	Name                 string              `json:"name"`
+	APIKeys              []ServiceAccountKey `json:"api-keys,omitempty"`
 }
 
 type WorkspaceResource struct {
@@ -46,4 +102,33 @@ type WorkspaceResource struct {
 	Name         string `json:"name"`
 	OwnerID      string `json:"owner_id"`
 	Shared       bool   `json:"shared"`
+
+	CreatorUserID                string                `json:"creator_user_id,omitempty"`
+	AnonymousAccessLevelOverride *string               `json:"anonymous_access_level_override,omitempty"`
+	RoleToGroupIDs               map[string][]string   `json:"role_to_group_ids,omitempty"`
+	ShareOptions                 []ResourceShareOption `json:"share_options,omitempty"`
+}
+
+// ResourceShareOption is a principal the resource can still be shared with.
+type ResourceShareOption struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
 }
+
+// ShareWorkspaceResourceRequest targets exactly one principal: a user or
+// service account email, a group ID, or a workspace API key ID. The group ID
+// "default" targets the access every workspace member has by default.
+type ShareWorkspaceResourceRequest struct {
+	Role              string `json:"role,omitempty"`
+	ResourceType      string `json:"resource_type"`
+	UserEmail         string `json:"user_email,omitempty"`
+	GroupID           string `json:"group_id,omitempty"`
+	WorkspaceAPIKeyID string `json:"workspace_api_key_id,omitempty"`
+}
+
+type CopyWorkspaceResourceRequest struct {
+	ResourceType string `json:"resource_type"`
+	TargetUserID string `json:"target_user_id"`
+}
+
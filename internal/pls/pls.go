@@ -0,0 +1,141 @@
+// Package pls reads and writes W3C Pronunciation Lexicon Specification
+// documents, the format ElevenLabs uses to store pronunciation dictionary
+// versions.
+package pls
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+const (
+	RuleTypeAlias   = "alias"
+	RuleTypePhoneme = "phoneme"
+
+	AlphabetIPA = "ipa"
+	AlphabetCMU = "cmu-arpabet"
+
+	// DefaultLanguage is used when a lexicon does not declare xml:lang.
+	DefaultLanguage = "en-US"
+
+	namespace = "http://www.w3.org/2005/01/pronunciation-lexicon"
+)
+
+// Lexicon is a decoded PLS document.
+type Lexicon struct {
+	Language string
+	Alphabet string
+	Rules    []models.PronunciationRule
+}
+
+type lexiconXML struct {
+	XMLName  xml.Name    `xml:"lexicon"`
+	Version  string      `xml:"version,attr"`
+	Xmlns    string      `xml:"xmlns,attr,omitempty"`
+	Alphabet string      `xml:"alphabet,attr"`
+	Lang     string      `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Lexemes  []lexemeXML `xml:"lexeme"`
+}
+
+type lexemeXML struct {
+	Graphemes []string     `xml:"grapheme"`
+	Aliases   []string     `xml:"alias"`
+	Phonemes  []phonemeXML `xml:"phoneme"`
+}
+
+type phonemeXML struct {
+	Alphabet string `xml:"alphabet,attr,omitempty"`
+	Value    string `xml:",chardata"`
+}
+
+// Decode parses a PLS document. Every grapheme of a lexeme becomes its own
+// rule; an alias takes precedence over a phoneme when a lexeme carries both.
+func Decode(data []byte) (*Lexicon, error) {
+	var doc lexiconXML
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("parsing PLS document: %w", err)
+	}
+
+	lex := &Lexicon{
+		Language: doc.Lang,
+		Alphabet: doc.Alphabet,
+	}
+	if lex.Language == "" {
+		lex.Language = DefaultLanguage
+	}
+
+	for _, lx := range doc.Lexemes {
+		rule := models.PronunciationRule{}
+		switch {
+		case len(lx.Aliases) > 0:
+			rule.Type = RuleTypeAlias
+			rule.Alias = strings.TrimSpace(lx.Aliases[0])
+		case len(lx.Phonemes) > 0:
+			rule.Type = RuleTypePhoneme
+			rule.Phoneme = strings.TrimSpace(lx.Phonemes[0].Value)
+			rule.Alphabet = lx.Phonemes[0].Alphabet
+			if rule.Alphabet == "" {
+				rule.Alphabet = doc.Alphabet
+			}
+		default:
+			continue
+		}
+
+		for _, grapheme := range lx.Graphemes {
+			r := rule
+			r.StringToReplace = strings.TrimSpace(grapheme)
+			lex.Rules = append(lex.Rules, r)
+		}
+	}
+
+	return lex, nil
+}
+
+// Encode renders a lexicon as a PLS document. Rules are validated first so
+// that a malformed rule is reported before anything is uploaded.
+func Encode(lex *Lexicon) ([]byte, error) {
+	alphabet := lex.Alphabet
+	if alphabet == "" {
+		alphabet = AlphabetIPA
+	}
+	language := lex.Language
+	if language == "" {
+		language = DefaultLanguage
+	}
+
+	doc := lexiconXML{
+		Version:  "1.0",
+		Xmlns:    namespace,
+		Alphabet: alphabet,
+		Lang:     language,
+	}
+
+	for i, rule := range lex.Rules {
+		if err := ValidateRule(rule); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+
+		lx := lexemeXML{Graphemes: []string{rule.StringToReplace}}
+		if rule.Type == RuleTypeAlias {
+			lx.Aliases = []string{rule.Alias}
+		} else {
+			ph := phonemeXML{Value: rule.Phoneme}
+			if rule.Alphabet != alphabet {
+				ph.Alphabet = rule.Alphabet
+			}
+			lx.Phonemes = []phonemeXML{ph}
+		}
+		doc.Lexemes = append(doc.Lexemes, lx)
+	}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), append(out, '\n')...), nil
+}
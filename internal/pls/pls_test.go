@@ -0,0 +1,136 @@
+package pls
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+func TestDecode(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-GB">
+  <lexeme>
+    <grapheme>NY</grapheme>
+    <grapheme>N.Y.</grapheme>
+    <alias>New York</alias>
+  </lexeme>
+  <lexeme>
+    <grapheme>tomato</grapheme>
+    <phoneme>təˈmeɪtoʊ</phoneme>
+  </lexeme>
+  <lexeme>
+    <grapheme>Kubernetes</grapheme>
+    <phoneme alphabet="cmu-arpabet">K UW1 B ER0 N EH1 T IY0 Z</phoneme>
+  </lexeme>
+</lexicon>`
+
+	lex, err := Decode([]byte(doc))
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+
+	if lex.Language != "en-GB" {
+		t.Errorf("Expected language 'en-GB', got '%s'", lex.Language)
+	}
+	if lex.Alphabet != "ipa" {
+		t.Errorf("Expected alphabet 'ipa', got '%s'", lex.Alphabet)
+	}
+
+	expected := []models.PronunciationRule{
+		{Type: "alias", StringToReplace: "NY", Alias: "New York"},
+		{Type: "alias", StringToReplace: "N.Y.", Alias: "New York"},
+		{Type: "phoneme", StringToReplace: "tomato", Phoneme: "təˈmeɪtoʊ", Alphabet: "ipa"},
+		{Type: "phoneme", StringToReplace: "Kubernetes", Phoneme: "K UW1 B ER0 N EH1 T IY0 Z", Alphabet: "cmu-arpabet"},
+	}
+	if len(lex.Rules) != len(expected) {
+		t.Fatalf("Expected %d rules, got %d: %+v", len(expected), len(lex.Rules), lex.Rules)
+	}
+	for i, rule := range lex.Rules {
+		if rule != expected[i] {
+			t.Errorf("Rule %d: expected %+v, got %+v", i, expected[i], rule)
+		}
+	}
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	if _, err := Decode([]byte("not xml")); err == nil {
+		t.Errorf("Expected error for invalid document")
+	}
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	lex := &Lexicon{
+		Language: "en-US",
+		Rules: []models.PronunciationRule{
+			{Type: "alias", StringToReplace: "AT&T", Alias: "A T and T"},
+			{Type: "phoneme", StringToReplace: "tomato", Phoneme: "təˈmeɪtoʊ", Alphabet: "ipa"},
+			{Type: "phoneme", StringToReplace: "Kubernetes", Phoneme: "K UW1 B ER0 N EH1 T IY0 Z", Alphabet: "cmu-arpabet"},
+		},
+	}
+
+	data, err := Encode(lex)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	if !strings.Contains(string(data), `xml:lang="en-US"`) {
+		t.Errorf("Expected xml:lang attribute in output, got:\n%s", data)
+	}
+
+	decoded, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode failed: %v\n%s", err, data)
+	}
+	if len(decoded.Rules) != len(lex.Rules) {
+		t.Fatalf("Expected %d rules, got %d", len(lex.Rules), len(decoded.Rules))
+	}
+	for i, rule := range decoded.Rules {
+		if rule != lex.Rules[i] {
+			t.Errorf("Rule %d: expected %+v, got %+v", i, lex.Rules[i], rule)
+		}
+	}
+}
+
+func TestEncode_InvalidRule(t *testing.T) {
+	lex := &Lexicon{
+		Rules: []models.PronunciationRule{
+			{Type: "phoneme", StringToReplace: "tomato", Phoneme: "TOMATO", Alphabet: "ipa"},
+		},
+	}
+
+	if _, err := Encode(lex); err == nil {
+		t.Errorf("Expected error for invalid IPA phoneme")
+	}
+}
+
+func TestValidateRule(t *testing.T) {
+	cases := []struct {
+		name  string
+		rule  models.PronunciationRule
+		valid bool
+	}{
+		{"alias", models.PronunciationRule{Type: "alias", StringToReplace: "NY", Alias: "New York"}, true},
+		{"alias without alias", models.PronunciationRule{Type: "alias", StringToReplace: "NY"}, false},
+		{"empty string", models.PronunciationRule{Type: "alias", Alias: "New York"}, false},
+		{"unknown type", models.PronunciationRule{Type: "spelling", StringToReplace: "NY"}, false},
+		{"ipa", models.PronunciationRule{Type: "phoneme", StringToReplace: "thought", Phoneme: "θɔːt", Alphabet: "ipa"}, true},
+		{"ipa with digits", models.PronunciationRule{Type: "phoneme", StringToReplace: "thought", Phoneme: "TH AO1 T", Alphabet: "ipa"}, false},
+		{"cmu", models.PronunciationRule{Type: "phoneme", StringToReplace: "thought", Phoneme: "TH AO1 T", Alphabet: "cmu-arpabet"}, true},
+		{"cmu unknown symbol", models.PronunciationRule{Type: "phoneme", StringToReplace: "thought", Phoneme: "TH XX1 T", Alphabet: "cmu-arpabet"}, false},
+		{"cmu stressed consonant", models.PronunciationRule{Type: "phoneme", StringToReplace: "thought", Phoneme: "TH1 AO1 T", Alphabet: "cmu-arpabet"}, false},
+		{"cmu lowercase", models.PronunciationRule{Type: "phoneme", StringToReplace: "thought", Phoneme: "th ao1 t", Alphabet: "cmu-arpabet"}, false},
+		{"missing alphabet", models.PronunciationRule{Type: "phoneme", StringToReplace: "thought", Phoneme: "θɔːt"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateRule(tc.rule)
+			if tc.valid && err != nil {
+				t.Errorf("Expected rule to be valid, got: %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Errorf("Expected rule to be invalid")
+			}
+		})
+	}
+}
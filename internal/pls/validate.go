@@ -0,0 +1,108 @@
+package pls
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+// Alphabets lists the phonetic alphabets ElevenLabs accepts for phoneme rules.
+var Alphabets = []string{AlphabetIPA, AlphabetCMU}
+
+var cmuPhonemes = map[string]bool{
+	"AA": true, "AE": true, "AH": true, "AO": true, "AW": true, "AY": true,
+	"B": true, "CH": true, "D": true, "DH": true, "EH": true, "ER": true,
+	"EY": true, "F": true, "G": true, "HH": true, "IH": true, "IY": true,
+	"JH": true, "K": true, "L": true, "M": true, "N": true, "NG": true,
+	"OW": true, "OY": true, "P": true, "R": true, "S": true, "SH": true,
+	"T": true, "TH": true, "UH": true, "UW": true, "V": true, "W": true,
+	"Y": true, "Z": true, "ZH": true,
+}
+
+var cmuVowels = map[string]bool{
+	"AA": true, "AE": true, "AH": true, "AO": true, "AW": true, "AY": true,
+	"EH": true, "ER": true, "EY": true, "IH": true, "IY": true, "OW": true,
+	"OY": true, "UH": true, "UW": true,
+}
+
+// ValidateRule checks that a rule has the fields its type requires and that
+// phonemes are written in the declared alphabet.
+func ValidateRule(rule models.PronunciationRule) error {
+	if strings.TrimSpace(rule.StringToReplace) == "" {
+		return fmt.Errorf("string_to_replace must not be empty")
+	}
+
+	switch rule.Type {
+	case RuleTypeAlias:
+		if rule.Alias == "" {
+			return fmt.Errorf("alias rule for %q requires alias", rule.StringToReplace)
+		}
+		return nil
+	case RuleTypePhoneme:
+		if rule.Phoneme == "" {
+			return fmt.Errorf("phoneme rule for %q requires phoneme", rule.StringToReplace)
+		}
+		return ValidatePhoneme(rule.Alphabet, rule.Phoneme)
+	default:
+		return fmt.Errorf("rule type for %q must be %q or %q, got %q", rule.StringToReplace, RuleTypeAlias, RuleTypePhoneme, rule.Type)
+	}
+}
+
+// ValidatePhoneme checks a pronunciation against the rules of its alphabet.
+func ValidatePhoneme(alphabet, phoneme string) error {
+	switch alphabet {
+	case AlphabetIPA:
+		return validateIPA(phoneme)
+	case AlphabetCMU:
+		return validateCMU(phoneme)
+	default:
+		return fmt.Errorf("alphabet must be one of %s, got %q", strings.Join(Alphabets, ", "), alphabet)
+	}
+}
+
+func validateIPA(phoneme string) error {
+	for _, r := range phoneme {
+		if isIPARune(r) {
+			continue
+		}
+		return fmt.Errorf("%q is not a valid IPA symbol in %q", r, phoneme)
+	}
+	return nil
+}
+
+func isIPARune(r rune) bool {
+	switch {
+	case r == ' ', r == '.', r == '|', r == '‖', r == '‿':
+		return true
+	case r >= 0x0250 && r <= 0x02FF: // IPA extensions and spacing modifiers
+		return true
+	case r >= 0x0300 && r <= 0x036F: // combining diacritics
+		return true
+	case r == 'β', r == 'θ', r == 'χ':
+		return true
+	case r == 'ᵻ', r == 'ᵿ', r == 'ⁿ', r == 'ˀ':
+		return true
+	}
+	return unicode.Is(unicode.Latin, r) && unicode.IsLower(r)
+}
+
+func validateCMU(phoneme string) error {
+	symbols := strings.Fields(phoneme)
+	if len(symbols) == 0 {
+		return fmt.Errorf("phoneme must not be empty")
+	}
+
+	for _, symbol := range symbols {
+		base := strings.TrimRight(symbol, "012")
+		stressed := base != symbol
+		if !cmuPhonemes[base] || len(symbol)-len(base) > 1 {
+			return fmt.Errorf("%q is not a valid CMU Arpabet symbol in %q", symbol, phoneme)
+		}
+		if stressed && !cmuVowels[base] {
+			return fmt.Errorf("stress marker on consonant %q in %q", symbol, phoneme)
+		}
+	}
+	return nil
+}
@@ -2,10 +2,16 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func stringSliceFromList(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
@@ -46,3 +52,53 @@ func jsonStringValue(value interface{}) types.String {
 
 	return types.StringValue(string(data))
 }
+
+// rootAttributesKnown reports whether the named top-level attributes of a
+// configuration are wholly known, so they can be decoded into structs that
+// cannot hold unknown values.
+func rootAttributesKnown(config tftypes.Value, names ...string) bool {
+	for _, name := range names {
+		value, _, err := tftypes.WalkAttributePath(config, tftypes.NewAttributePath().WithAttributeName(name))
+		if err != nil {
+			return false
+		}
+		if v, ok := value.(tftypes.Value); !ok || !v.IsFullyKnown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateWriteOnlyAlternative checks that exactly one of name and its
+// write-only alternative name_wo is configured, and that name_version is only
+// set alongside name_wo.
+func validateWriteOnlyAlternative(ctx context.Context, config tfsdk.Config, name string, diags *diag.Diagnostics) {
+	var value, valueWO types.String
+	var version types.Int64
+	diags.Append(config.GetAttribute(ctx, path.Root(name), &value)...)
+	diags.Append(config.GetAttribute(ctx, path.Root(name+"_wo"), &valueWO)...)
+	diags.Append(config.GetAttribute(ctx, path.Root(name+"_version"), &version)...)
+	if diags.HasError() || value.IsUnknown() || valueWO.IsUnknown() {
+		return
+	}
+
+	switch {
+	case !value.IsNull() && !valueWO.IsNull():
+		diags.AddAttributeError(path.Root(name+"_wo"), "Invalid Configuration", fmt.Sprintf("Only one of `%s` or `%s_wo` may be set.", name, name))
+	case value.IsNull() && valueWO.IsNull():
+		diags.AddAttributeError(path.Root(name), "Invalid Configuration", fmt.Sprintf("Either `%s` or `%s_wo` must be provided.", name, name))
+	case valueWO.IsNull() && !version.IsNull():
+		diags.AddAttributeError(path.Root(name+"_version"), "Invalid Configuration", fmt.Sprintf("`%s_version` only applies to `%s_wo`.", name, name))
+	}
+}
+
+// newRandomID returns a random ID for resources that have no natural one in
+// the API.
+func newRandomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
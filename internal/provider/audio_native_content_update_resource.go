@@ -3,18 +3,41 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
 )
 
 var (
-	_ resource.Resource              = &AudioNativeContentUpdateResource{}
-	_ resource.ResourceWithConfigure = &AudioNativeContentUpdateResource{}
+	_ resource.Resource                   = &AudioNativeContentUpdateResource{}
+	_ resource.ResourceWithConfigure      = &AudioNativeContentUpdateResource{}
+	_ resource.ResourceWithValidateConfig = &AudioNativeContentUpdateResource{}
 )
 
+// audioNativePollInterval is how often the player settings are checked while
+// waiting for a conversion to finish.
+var audioNativePollInterval = 10 * time.Second
+
+// audioNativeSourceClient fetches source_url pages. The timeout keeps a slow
+// or unresponsive server from stalling the apply.
+var audioNativeSourceClient = &http.Client{Timeout: time.Minute}
+
+// maxAudioNativeSourceBytes caps the size of a source_url page (10 MiB), well
+// above any article Audio Native converts.
+var maxAudioNativeSourceBytes int64 = 10 << 20
+
+const audioNativeStatusReady = "ready"
+
 func NewAudioNativeContentUpdateResource() resource.Resource {
 	return &AudioNativeContentUpdateResource{}
 }
@@ -24,10 +47,19 @@ type AudioNativeContentUpdateResource struct {
 }
 
 type AudioNativeContentUpdateResourceModel struct {
-	ProjectID types.String `tfsdk:"project_id"`
-	FilePath  types.String `tfsdk:"file_path"`
-	VoiceID   types.String `tfsdk:"voice_id"`
-	ModelID   types.String `tfsdk:"model_id"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	FilePath     types.String `tfsdk:"file_path"`
+	Content      types.String `tfsdk:"content"`
+	SourceURL    types.String `tfsdk:"source_url"`
+	VoiceID      types.String `tfsdk:"voice_id"`
+	ModelID      types.String `tfsdk:"model_id"`
+	AutoConvert  types.Bool   `tfsdk:"auto_convert"`
+	AutoPublish  types.Bool   `tfsdk:"auto_publish"`
+	WaitForReady types.Bool   `tfsdk:"wait_for_ready"`
+	WaitTimeout  types.String `tfsdk:"wait_timeout"`
+	HTMLSnippet  types.String `tfsdk:"html_snippet"`
+	Status       types.String `tfsdk:"status"`
+	Workspace    types.String `tfsdk:"workspace"`
 }
 
 func (r *AudioNativeContentUpdateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -36,7 +68,8 @@ func (r *AudioNativeContentUpdateResource) Metadata(ctx context.Context, req res
 
 func (r *AudioNativeContentUpdateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Resource for updating Audio Native project content in ElevenLabs. Allows changing the audio file and voice/model settings.",
+		MarkdownDescription: "Resource for updating Audio Native project content in ElevenLabs. " +
+			"Content can come from a local file, an inline string or a URL that is fetched at apply time.",
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				Required:            true,
@@ -44,7 +77,15 @@ func (r *AudioNativeContentUpdateResource) Schema(ctx context.Context, req resou
 			},
 			"file_path": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Path to the new audio file.",
+				MarkdownDescription: "Path to a text or HTML file with the new content. Exactly one of `file_path`, `content` or `source_url` must be set.",
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Inline text or HTML content. HTML should be wrapped in `<html><body>...</body></html>`.",
+			},
+			"source_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "URL of a text or HTML page whose body is uploaded as the new content. The page must load within one minute and be at most 10 MiB.",
 			},
 			"voice_id": schema.StringAttribute{
 				Optional:            true,
@@ -54,6 +95,33 @@ func (r *AudioNativeContentUpdateResource) Schema(ctx context.Context, req resou
 				Optional:            true,
 				MarkdownDescription: "The model ID to use for TTS.",
 			},
+			"auto_convert": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to convert the new content to audio.",
+			},
+			"auto_publish": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to publish the new snapshot once it is converted.",
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Wait until the player status is `ready` before completing the apply.",
+			},
+			"wait_timeout": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("10m"),
+				MarkdownDescription: "How long to wait for the player to become ready, as a Go duration. Defaults to `10m`.",
+			},
+			"html_snippet": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "HTML snippet that embeds the player.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Player status after the update. Null when the API returns no player settings.",
+			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -75,6 +143,33 @@ func (r *AudioNativeContentUpdateResource) Configure(ctx context.Context, req re
 	r.client = client
 }
 
+func (r *AudioNativeContentUpdateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AudioNativeContentUpdateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if rootAttributesKnown(req.Config.Raw, "file_path", "content", "source_url") {
+		sources := 0
+		for _, v := range []types.String{data.FilePath, data.Content, data.SourceURL} {
+			if !v.IsNull() {
+				sources++
+			}
+		}
+		if sources != 1 {
+			resp.Diagnostics.AddError("Invalid Configuration", "Exactly one of `file_path`, `content` or `source_url` must be set.")
+		}
+	}
+
+	if data.WaitTimeout.IsNull() || data.WaitTimeout.IsUnknown() {
+		return
+	}
+	if _, err := time.ParseDuration(data.WaitTimeout.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_timeout"), "Invalid Configuration", fmt.Sprintf("wait_timeout must be a duration such as \"10m\": %s", err))
+	}
+}
+
 func (r *AudioNativeContentUpdateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data AudioNativeContentUpdateResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -82,15 +177,14 @@ func (r *AudioNativeContentUpdateResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	err := r.client.UpdateAudioNativeContent(
-		data.ProjectID.ValueString(),
-		data.FilePath.ValueString(),
-		data.VoiceID.ValueString(),
-		data.ModelID.ValueString(),
-	)
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating Audio Native content", err.Error())
+	resp.Diagnostics.Append(r.updateContent(ctx, c, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -108,15 +202,14 @@ func (r *AudioNativeContentUpdateResource) Update(ctx context.Context, req resou
 		return
 	}
 
-	err := r.client.UpdateAudioNativeContent(
-		data.ProjectID.ValueString(),
-		data.FilePath.ValueString(),
-		data.VoiceID.ValueString(),
-		data.ModelID.ValueString(),
-	)
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating Audio Native content", err.Error())
+	resp.Diagnostics.Append(r.updateContent(ctx, c, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -126,3 +219,126 @@ func (r *AudioNativeContentUpdateResource) Update(ctx context.Context, req resou
 func (r *AudioNativeContentUpdateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This is an action resource, no deletion needed
 }
+
+func (r *AudioNativeContentUpdateResource) updateContent(ctx context.Context, c *client.Client, data *AudioNativeContentUpdateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	updateReq := &models.UpdateAudioNativeContentRequest{
+		FilePath:    data.FilePath.ValueString(),
+		VoiceID:     data.VoiceID.ValueString(),
+		ModelID:     data.ModelID.ValueString(),
+		AutoConvert: data.AutoConvert.ValueBool(),
+		AutoPublish: data.AutoPublish.ValueBool(),
+	}
+
+	switch {
+	case !data.Content.IsNull():
+		updateReq.Content = []byte(data.Content.ValueString())
+		updateReq.FileName = audioNativeContentFileName(data.Content.ValueString())
+	case !data.SourceURL.IsNull():
+		content, err := fetchAudioNativeSource(ctx, data.SourceURL.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("source_url"), "Error fetching Audio Native content", err.Error())
+			return diags
+		}
+		updateReq.Content = content
+		updateReq.FileName = audioNativeContentFileName(string(content))
+	}
+
+	projectID := data.ProjectID.ValueString()
+	project, err := c.UpdateAudioNativeContent(projectID, updateReq)
+	if err != nil {
+		diags.AddError("Error updating Audio Native content", err.Error())
+		return diags
+	}
+	data.HTMLSnippet = optionalStringValue(project.HTMLSnippet)
+
+	var status types.String
+	if data.WaitForReady.ValueBool() {
+		// wait_timeout was checked by ValidateConfig.
+		timeout, _ := time.ParseDuration(data.WaitTimeout.ValueString())
+		status, err = waitForAudioNativeReady(ctx, c, projectID, timeout)
+	} else {
+		status, err = audioNativeStatus(c, projectID)
+	}
+	if err != nil {
+		diags.AddError("Error waiting for Audio Native conversion", err.Error())
+		return diags
+	}
+	data.Status = status
+
+	return diags
+}
+
+// audioNativeStatus returns the conversion status, or null when the API
+// returns no player settings.
+func audioNativeStatus(c *client.Client, projectID string) (types.String, error) {
+	project, err := c.GetAudioNativeSettings(projectID)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	if project.Settings == nil {
+		return types.StringNull(), nil
+	}
+	return types.StringValue(project.Settings.Status), nil
+}
+
+// waitForAudioNativeReady polls the player settings until the project reports
+// the ready status or the timeout expires.
+func waitForAudioNativeReady(ctx context.Context, c *client.Client, projectID string, timeout time.Duration) (types.String, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		status, err := audioNativeStatus(c, projectID)
+		if err != nil {
+			return types.StringNull(), err
+		}
+		if status.ValueString() == audioNativeStatusReady {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("project %s was still %q after %s", projectID, status.ValueString(), timeout)
+		case <-time.After(audioNativePollInterval):
+		}
+	}
+}
+
+func fetchAudioNativeSource(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := audioNativeSourceClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("fetching %s returned status %d", url, resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxAudioNativeSourceBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > maxAudioNativeSourceBytes {
+		return nil, fmt.Errorf("%s is larger than the %d byte limit", url, maxAudioNativeSourceBytes)
+	}
+
+	return content, nil
+}
+
+// audioNativeContentFileName picks an upload name so the API parses the
+// content as HTML or plain text.
+func audioNativeContentFileName(content string) string {
+	lower := strings.ToLower(content)
+	if strings.Contains(lower, "<html") || strings.Contains(lower, "<body") {
+		return "content.html"
+	}
+	return "content.txt"
+}
@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"html"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +20,7 @@ var (
 	_ resource.Resource                = &AudioNativeResource{}
 	_ resource.ResourceWithConfigure   = &AudioNativeResource{}
 	_ resource.ResourceWithImportState = &AudioNativeResource{}
+	_ resource.ResourceWithModifyPlan  = &AudioNativeResource{}
 )
 
 func NewAudioNativeResource() resource.Resource {
@@ -41,6 +44,7 @@ type AudioNativeResourceModel struct {
 	AutoConvert     types.Bool   `tfsdk:"auto_convert"`
 	HTMLSnippet     types.String `tfsdk:"html_snippet"`
 	Status          types.String `tfsdk:"status"`
+	Workspace       types.String `tfsdk:"workspace"`
 }
 
 func (r *AudioNativeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,37 +63,73 @@ func (r *AudioNativeResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"name": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceIfPreviouslySet(),
+				},
 			},
 			"file_path": schema.StringAttribute{
-				Required: true,
+				Required:            true,
+				MarkdownDescription: "Path to a text or HTML file with the article content. Changing this forces a new project; use `elevenlabs_audio_native_content_update` to replace content in place.",
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceIfPreviouslySet(),
+				},
 			},
 			"voice_id": schema.StringAttribute{
-				Optional: true,
+				Optional:            true,
+				MarkdownDescription: "Voice used by the player. Updated in place.",
 			},
 			"model_id": schema.StringAttribute{
-				Optional: true,
+				Optional:            true,
+				MarkdownDescription: "TTS model used by the player. Updated in place.",
 			},
 			"title": schema.StringAttribute{
-				Optional: true,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Title shown in the player. Defaults to the player settings of the workspace. Can only be set when the project is created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"author": schema.StringAttribute{
-				Optional: true,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Author shown in the player. Defaults to the player settings of the workspace. Can only be set when the project is created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"text_color": schema.StringAttribute{
-				Optional: true,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Text color of the player. Defaults to the player settings of the workspace. Can only be set when the project is created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"background_color": schema.StringAttribute{
-				Optional: true,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Background color of the player. Defaults to the player settings of the workspace. Can only be set when the project is created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"auto_convert": schema.BoolAttribute{
-				Optional: true,
+				Optional:            true,
+				MarkdownDescription: "Whether to convert the content to audio when the project is created. Changing it later has no effect on the project.",
 			},
 			"html_snippet": schema.StringAttribute{
-				Computed: true,
+				Computed:            true,
+				MarkdownDescription: "HTML snippet that embeds the player. Rendered from the project ID when the project is imported.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"status": schema.StringAttribute{
-				Computed: true,
+				Computed:            true,
+				MarkdownDescription: "Conversion status reported by the player settings.",
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -118,6 +158,12 @@ func (r *AudioNativeResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq := &models.CreateAudioNativeRequest{
 		Name:            data.Name.ValueString(),
 		FilePath:        data.FilePath.ValueString(),
@@ -130,7 +176,7 @@ func (r *AudioNativeResource) Create(ctx context.Context, req resource.CreateReq
 		AutoConvert:     data.AutoConvert.ValueBool(),
 	}
 
-	project, err := r.client.CreateAudioNative(addReq)
+	project, err := c.CreateAudioNative(addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating audio native project", err.Error())
 		return
@@ -139,16 +185,10 @@ func (r *AudioNativeResource) Create(ctx context.Context, req resource.CreateReq
 	data.ID = types.StringValue(project.ProjectID)
 	data.HTMLSnippet = types.StringValue(project.HTMLSnippet)
 
-	settings, err := r.client.GetAudioNativeSettings(project.ProjectID)
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading audio native settings", err.Error())
+	resp.Diagnostics.Append(readAudioNativeSettings(c, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	data.Title = types.StringValue(settings.Title)
-	data.Author = types.StringValue(settings.Author)
-	data.TextColor = types.StringValue(settings.TextColor)
-	data.BackgroundColor = types.StringValue(settings.BackgroundColor)
-	data.Status = types.StringValue(settings.Status)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -160,24 +200,101 @@ func (r *AudioNativeResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	settings, err := r.client.GetAudioNativeSettings(data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading audio native settings", err.Error())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.Title = types.StringValue(settings.Title)
-	data.Author = types.StringValue(settings.Author)
-	data.TextColor = types.StringValue(settings.TextColor)
-	data.BackgroundColor = types.StringValue(settings.BackgroundColor)
-	data.Status = types.StringValue(settings.Status)
+	resp.Diagnostics.Append(readAudioNativeSettings(c, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.HTMLSnippet.IsNull() || data.HTMLSnippet.ValueString() == "" {
+		data.HTMLSnippet = types.StringValue(audioNativeHTMLSnippet(data.ID.ValueString()))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ModifyPlan rejects changes to player settings the API cannot update. The
+// only settings endpoint is read-only, and replacing the project would change
+// its ID and break every page that embeds the player.
+func (r *AudioNativeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AudioNativeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, setting := range []struct {
+		name         string
+		planned, old types.String
+	}{
+		{"title", plan.Title, state.Title},
+		{"author", plan.Author, state.Author},
+		{"text_color", plan.TextColor, state.TextColor},
+		{"background_color", plan.BackgroundColor, state.BackgroundColor},
+	} {
+		if setting.planned.IsUnknown() || setting.old.IsNull() || setting.planned.Equal(setting.old) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(setting.name),
+			"Setting Cannot Be Updated",
+			fmt.Sprintf("The ElevenLabs API cannot change `%s` after an Audio Native project is created (currently %q). "+
+				"Change it in the ElevenLabs dashboard and update the configuration to match, or replace the project with `terraform apply -replace`, "+
+				"which changes its ID and embed snippet.", setting.name, setting.old.ValueString()),
+		)
+	}
+}
+
 func (r *AudioNativeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Update logic could involve updating content or settings if endpoints exist.
-	resp.Diagnostics.AddWarning("Update limited", "Updating audio native projects might requires replacement.")
+	var data AudioNativeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state AudioNativeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Voice and model are the only player settings the content endpoint
+	// accepts; the rest were rejected in ModifyPlan.
+	voiceChanged := !data.VoiceID.IsNull() && !data.VoiceID.Equal(state.VoiceID)
+	modelChanged := !data.ModelID.IsNull() && !data.ModelID.Equal(state.ModelID)
+	if voiceChanged || modelChanged {
+		_, err := c.UpdateAudioNativeContent(data.ID.ValueString(), &models.UpdateAudioNativeContentRequest{
+			VoiceID: data.VoiceID.ValueString(),
+			ModelID: data.ModelID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating audio native voice", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(readAudioNativeSettings(c, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *AudioNativeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -187,8 +304,14 @@ func (r *AudioNativeResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Assuming standard project delete works since Audio Native returns a project ID
-	err := r.client.DeleteProject(data.ID.ValueString())
+	err := c.DeleteProject(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting audio native project", err.Error())
 		return
@@ -196,5 +319,59 @@ func (r *AudioNativeResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *AudioNativeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
+}
+
+// requiresReplaceIfPreviouslySet forces replacement when a create-only value
+// changes, but not when it is first recorded after import.
+func requiresReplaceIfPreviouslySet() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Changing this value after creation forces a new resource.",
+		"Changing this value after creation forces a new resource.",
+	)
+}
+
+// readAudioNativeSettings copies the player settings reported by the API into
+// the model so that changes made outside Terraform show up as drift.
+func readAudioNativeSettings(c *client.Client, data *AudioNativeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	project, err := c.GetAudioNativeSettings(data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Error reading audio native settings", err.Error())
+		return diags
+	}
+
+	// Projects that have not been converted yet may report no settings; keep
+	// the prior or planned values rather than recording empty strings.
+	settings := project.Settings
+	if settings == nil {
+		for _, value := range []*types.String{&data.Title, &data.Author, &data.TextColor, &data.BackgroundColor} {
+			if value.IsUnknown() {
+				*value = types.StringNull()
+			}
+		}
+		data.Status = types.StringNull()
+		return diags
+	}
+
+	data.Title = types.StringValue(settings.Title)
+	data.Author = types.StringValue(settings.Author)
+	data.TextColor = types.StringValue(settings.TextColor)
+	data.BackgroundColor = types.StringValue(settings.BackgroundColor)
+	data.Status = optionalStringValue(settings.Status)
+
+	return diags
+}
+
+// audioNativeHTMLSnippet renders the embed code for a project. The API only
+// returns the snippet when content is uploaded, so imported projects use this.
+func audioNativeHTMLSnippet(projectID string) string {
+	return `<div id="elevenlabs-audionative-widget" data-height="90" data-width="100%" data-frameborder="no" data-scrolling="no" ` +
+		`data-projectid="` + html.EscapeString(projectID) + `" data-playerurl="https://elevenlabs.io/player/index.html">` +
+		`Loading the <a href="https://elevenlabs.io/text-to-speech" target="_blank" rel="noopener">Elevenlabs Text to Speech</a> AudioNative Player...</div>` +
+		`<script src="https://elevenlabs.io/player/audioNativeHelper.js" type="text/javascript"></script>`
 }
@@ -0,0 +1,450 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+func TestAccAudioNativeResource(t *testing.T) {
+	contentFile := writeTempFile(t, "article.html", []byte("<html><body><p>Hello</p></body></html>"))
+
+	var mu sync.Mutex
+	settings := models.AudioNativeSettings{
+		Title:           "Default Title",
+		Author:          "Default Author",
+		TextColor:       "#000000",
+		BackgroundColor: "#ffffff",
+		Status:          "ready",
+	}
+	creates := 0
+	var voiceUpdates []string
+
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodPost,
+			Path:   "/audio-native",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseMultipartForm(1 << 20); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				mu.Lock()
+				creates++
+				for field, value := range map[string]*string{
+					"title":            &settings.Title,
+					"author":           &settings.Author,
+					"text_color":       &settings.TextColor,
+					"background_color": &settings.BackgroundColor,
+				} {
+					if v := r.FormValue(field); v != "" {
+						*value = v
+					}
+				}
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"project_id":"audio-123","converting":false,"html_snippet":"<div>audio</div>"}`))
+			},
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/audio-native/audio-123/settings",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.AudioNativeProjectSettings{
+					Enabled:  true,
+					Settings: &settings,
+				})
+			},
+		},
+		{
+			Method: httpMethodPost,
+			Path:   "/audio-native/audio-123/content",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseMultipartForm(1 << 20); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				mu.Lock()
+				voiceUpdates = append(voiceUpdates, r.FormValue("voice_id")+"/"+r.FormValue("model_id"))
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"project_id":"audio-123","converting":true}`))
+			},
+		},
+		{
+			Method: httpMethodDelete,
+			Path:   "/projects/audio-123",
+		},
+	})
+	defer server.Close()
+
+	config := func(title, voice string) string {
+		return fmt.Sprintf(`
+%s
+
+resource "elevenlabs_audio_native" "player" {
+  name             = "Blog Player"
+  file_path        = "%s"
+  title            = "%s"
+  author           = "Docs Team"
+  text_color       = "#222222"
+  background_color = "#fafafa"
+  voice_id         = "%s"
+  model_id         = "eleven_multilingual_v2"
+}
+`, testAccProviderConfig(server.URL), contentFile, title, voice)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Launch Post", "voice-123"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_audio_native.player", "id", "audio-123"),
+					resource.TestCheckResourceAttr("elevenlabs_audio_native.player", "title", "Launch Post"),
+					resource.TestCheckResourceAttr("elevenlabs_audio_native.player", "author", "Docs Team"),
+					resource.TestCheckResourceAttr("elevenlabs_audio_native.player", "html_snippet", "<div>audio</div>"),
+					resource.TestCheckResourceAttr("elevenlabs_audio_native.player", "status", "ready"),
+				),
+			},
+			{
+				Config: config("Launch Post", "voice-456"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_audio_native.player", "id", "audio-123"),
+					resource.TestCheckResourceAttr("elevenlabs_audio_native.player", "voice_id", "voice-456"),
+					resource.TestCheckResourceAttr("elevenlabs_audio_native.player", "html_snippet", "<div>audio</div>"),
+					func(_ *terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+						if creates != 1 {
+							return fmt.Errorf("expected a voice change to update the project in place, got %d creates", creates)
+						}
+						if len(voiceUpdates) != 1 || voiceUpdates[0] != "voice-456/eleven_multilingual_v2" {
+							return fmt.Errorf("unexpected voice updates: %v", voiceUpdates)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Settings the API cannot change are rejected at plan time
+				// instead of replacing the published player.
+				Config:      config("Launch Post (Updated)", "voice-456"),
+				ExpectError: regexp.MustCompile("Setting Cannot Be Updated"),
+			},
+			{
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					settings.Title = "Edited In Dashboard"
+				},
+				Config: config("Edited In Dashboard", "voice-456"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_audio_native.player", "title", "Edited In Dashboard"),
+					func(_ *terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+						if creates != 1 {
+							return fmt.Errorf("expected the project to be kept, got %d creates", creates)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "elevenlabs_audio_native.player",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file_path", "name", "voice_id", "model_id", "html_snippet", "workspace"},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+					if !strings.Contains(states[0].Attributes["html_snippet"], `data-projectid="audio-123"`) {
+						return fmt.Errorf("expected html_snippet to embed the project, got %q", states[0].Attributes["html_snippet"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccAudioNativeContentUpdateResource(t *testing.T) {
+	pollInterval := audioNativePollInterval
+	audioNativePollInterval = 10 * time.Millisecond
+	defer func() { audioNativePollInterval = pollInterval }()
+
+	var mu sync.Mutex
+	var uploaded []string
+	pendingPolls := 0
+
+	var serverURL string
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/articles/launch",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html")
+				_, _ = w.Write([]byte("<html><body><p>From the CMS</p></body></html>"))
+			},
+		},
+		{
+			Method: httpMethodPost,
+			Path:   "/audio-native/audio-123/content",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				file, header, err := r.FormFile("file")
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				defer file.Close() //nolint:errcheck
+				body, _ := io.ReadAll(file)
+
+				mu.Lock()
+				uploaded = append(uploaded, header.Filename+":"+string(body))
+				if r.FormValue("auto_convert") == "true" {
+					pendingPolls = 2
+				}
+				mu.Unlock()
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"project_id":"audio-123","converting":true,"publishing":false,"html_snippet":"<div>audio</div>"}`))
+			},
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/audio-native/audio-123/settings",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				status := "ready"
+				if pendingPolls > 0 {
+					pendingPolls--
+					status = "processing"
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"enabled":true,"settings":{"title":"T","author":"A","text_color":"#000000","background_color":"#ffffff","status":%q}}`, status)
+			},
+		},
+	})
+	defer server.Close()
+	serverURL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_audio_native_content_update" "post" {
+  project_id     = "audio-123"
+  content        = "<html><body><p>Inline post</p></body></html>"
+  auto_convert   = true
+  wait_for_ready = true
+  wait_timeout   = "30s"
+}
+`, testAccProviderConfig(serverURL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_audio_native_content_update.post", "status", "ready"),
+					resource.TestCheckResourceAttr("elevenlabs_audio_native_content_update.post", "html_snippet", "<div>audio</div>"),
+					func(*terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+						if len(uploaded) != 1 || uploaded[0] != "content.html:<html><body><p>Inline post</p></body></html>" {
+							return fmt.Errorf("unexpected uploads: %v", uploaded)
+						}
+						if pendingPolls != 0 {
+							return fmt.Errorf("expected apply to wait for conversion, %d polls pending", pendingPolls)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_audio_native_content_update" "post" {
+  project_id = "audio-123"
+  source_url = "%s/articles/launch"
+}
+`, testAccProviderConfig(serverURL), serverURL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_audio_native_content_update.post", "wait_timeout", "10m"),
+					func(*terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+						if len(uploaded) != 2 || uploaded[1] != "content.html:<html><body><p>From the CMS</p></body></html>" {
+							return fmt.Errorf("unexpected uploads: %v", uploaded)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestWaitForAudioNativeReady_Timeout(t *testing.T) {
+	pollInterval := audioNativePollInterval
+	audioNativePollInterval = 5 * time.Millisecond
+	defer func() { audioNativePollInterval = pollInterval }()
+
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/audio-native/audio-123/settings",
+			Body:   `{"enabled":true,"settings":{"status":"processing"}}`,
+		},
+	})
+	defer server.Close()
+
+	c := client.NewClient("test-key", server.URL)
+	status, err := waitForAudioNativeReady(context.Background(), c, "audio-123", 30*time.Millisecond)
+	if err == nil {
+		t.Fatalf("Expected timeout error")
+	}
+	if status.ValueString() != "processing" {
+		t.Errorf("Expected last status 'processing', got %s", status)
+	}
+}
+
+func TestAudioNativeStatus_MissingSettings(t *testing.T) {
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/audio-native/audio-123/settings",
+			Body:   `{"enabled":false}`,
+		},
+	})
+	defer server.Close()
+
+	c := client.NewClient("test-key", server.URL)
+	status, err := audioNativeStatus(c, "audio-123")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !status.IsNull() {
+		t.Errorf("Expected a null status, got %s", status)
+	}
+}
+
+func TestAudioNativeContentUpdateResource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]tftypes.Value
+		err    bool
+	}{
+		{
+			name:   "content",
+			config: map[string]tftypes.Value{"content": tftypes.NewValue(tftypes.String, "<p>Launch</p>")},
+		},
+		{
+			name:   "unknown source",
+			config: map[string]tftypes.Value{"source_url": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		},
+		{
+			name: "no source",
+			err:  true,
+		},
+		{
+			name: "two sources",
+			config: map[string]tftypes.Value{
+				"content":    tftypes.NewValue(tftypes.String, "<p>Launch</p>"),
+				"source_url": tftypes.NewValue(tftypes.String, "https://example.com/post"),
+			},
+			err: true,
+		},
+		{
+			name: "invalid wait_timeout",
+			config: map[string]tftypes.Value{
+				"content":      tftypes.NewValue(tftypes.String, "<p>Launch</p>"),
+				"wait_timeout": tftypes.NewValue(tftypes.String, "ten minutes"),
+			},
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, NewAudioNativeContentUpdateResource(), tt.config)
+			if diags.HasError() != tt.err {
+				t.Errorf("expected error %t, got %v", tt.err, diags)
+			}
+		})
+	}
+}
+
+func TestFetchAudioNativeSource_SizeLimit(t *testing.T) {
+	maxBytes := maxAudioNativeSourceBytes
+	maxAudioNativeSourceBytes = 16
+	defer func() { maxAudioNativeSourceBytes = maxBytes }()
+
+	server := newTestServer(t, []testRoute{
+		{Method: httpMethodGet, Path: "/small.html", Body: "<p>Launch</p>"},
+		{Method: httpMethodGet, Path: "/large.html", Body: "<p>" + strings.Repeat("Launch ", 10) + "</p>"},
+	})
+	defer server.Close()
+
+	content, err := fetchAudioNativeSource(context.Background(), server.URL+"/small.html")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(content) != "<p>Launch</p>" {
+		t.Errorf("Unexpected content %q", content)
+	}
+
+	if _, err := fetchAudioNativeSource(context.Background(), server.URL+"/large.html"); err == nil || !strings.Contains(err.Error(), "byte limit") {
+		t.Errorf("Expected a size limit error, got %v", err)
+	}
+}
+
+func TestReadAudioNativeSettings_MissingSettings(t *testing.T) {
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/audio-native/audio-123/settings",
+			Body:   `{"enabled":false}`,
+		},
+	})
+	defer server.Close()
+
+	data := &AudioNativeResourceModel{
+		ID:              types.StringValue("audio-123"),
+		Title:           types.StringValue("Launch Post"),
+		Author:          types.StringUnknown(),
+		TextColor:       types.StringValue("#222222"),
+		BackgroundColor: types.StringUnknown(),
+	}
+
+	c := client.NewClient("test-key", server.URL)
+	if diags := readAudioNativeSettings(c, data); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	if data.Title.ValueString() != "Launch Post" || data.TextColor.ValueString() != "#222222" {
+		t.Errorf("Expected the prior settings to be kept, got title %q and text color %q", data.Title.ValueString(), data.TextColor.ValueString())
+	}
+	if !data.Author.IsNull() || !data.BackgroundColor.IsNull() {
+		t.Errorf("Expected unknown settings to become null, got author %s and background color %s", data.Author, data.BackgroundColor)
+	}
+}
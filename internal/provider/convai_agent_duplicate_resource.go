@@ -29,6 +29,7 @@ type ConvAIAgentDuplicatorResourceModel struct {
 	NewAgentID      types.String `tfsdk:"new_agent_id"`
 	NewAgentNameGet types.String `tfsdk:"new_agent_name_get"`
 	CreatedAt       types.String `tfsdk:"created_at"`
+	Workspace       types.String `tfsdk:"workspace"`
 }
 
 func (r *ConvAIAgentDuplicatorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -57,6 +58,7 @@ func (r *ConvAIAgentDuplicatorResource) Schema(ctx context.Context, req resource
 			"created_at": schema.StringAttribute{
 				Computed: true,
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -85,7 +87,13 @@ func (r *ConvAIAgentDuplicatorResource) Create(ctx context.Context, req resource
 		return
 	}
 
-	agent, err := r.client.DuplicateConvAIAgent(
+	c, diags := clientForWorkspace(r.client, plan.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agent, err := c.DuplicateConvAIAgent(
 		plan.SourceAgentID.ValueString(),
 		plan.NewAgentName.ValueString(),
 	)
@@ -109,7 +117,13 @@ func (r *ConvAIAgentDuplicatorResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	agent, err := r.client.GetConvAIAgent(state.NewAgentID.ValueString())
+	c, diags := clientForWorkspace(r.client, state.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agent, err := c.GetConvAIAgent(state.NewAgentID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading duplicated agent", err.Error())
 		return
@@ -128,8 +142,14 @@ func (r *ConvAIAgentDuplicatorResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, plan.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Duplicate again for update (creates another copy)
-	agent, err := r.client.DuplicateConvAIAgent(
+	agent, err := c.DuplicateConvAIAgent(
 		plan.SourceAgentID.ValueString(),
 		plan.NewAgentName.ValueString(),
 	)
@@ -152,7 +172,13 @@ func (r *ConvAIAgentDuplicatorResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	err := r.client.DeleteConvAIAgent(state.NewAgentID.ValueString())
+	c, diags := clientForWorkspace(r.client, state.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIAgent(state.NewAgentID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting duplicated agent", err.Error())
 		return
@@ -35,6 +35,7 @@ type ConvAIAgentResourceModel struct {
 	FirstMessage types.String `tfsdk:"first_message"`
 	Language     types.String `tfsdk:"language"`
 	ModelID      types.String `tfsdk:"model_id"`
+	Workspace    types.String `tfsdk:"workspace"`
 }
 
 func (r *ConvAIAgentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,6 +67,7 @@ func (r *ConvAIAgentResource) Schema(ctx context.Context, req resource.SchemaReq
 			"model_id": schema.StringAttribute{
 				Optional: true,
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -94,6 +96,12 @@ func (r *ConvAIAgentResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq := &models.CreateConvAIAgentRequest{
 		Name: data.Name.ValueString(),
 		Config: &models.ConvAIAgentConfig{
@@ -104,7 +112,7 @@ func (r *ConvAIAgentResource) Create(ctx context.Context, req resource.CreateReq
 		},
 	}
 
-	agent, err := r.client.CreateConvAIAgent(addReq)
+	agent, err := c.CreateConvAIAgent(addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating ConvAI agent", err.Error())
 		return
@@ -122,7 +130,13 @@ func (r *ConvAIAgentResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	agent, err := r.client.GetConvAIAgent(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agent, err := c.GetConvAIAgent(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ConvAI agent", err.Error())
 		return
@@ -146,6 +160,12 @@ func (r *ConvAIAgentResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := &models.CreateConvAIAgentRequest{
 		Name: data.Name.ValueString(),
 		Config: &models.ConvAIAgentConfig{
@@ -156,7 +176,7 @@ func (r *ConvAIAgentResource) Update(ctx context.Context, req resource.UpdateReq
 		},
 	}
 
-	err := r.client.UpdateConvAIAgent(data.ID.ValueString(), updateReq)
+	err := c.UpdateConvAIAgent(data.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating ConvAI agent", err.Error())
 		return
@@ -172,7 +192,13 @@ func (r *ConvAIAgentResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.client.DeleteConvAIAgent(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIAgent(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI agent", err.Error())
 		return
@@ -180,5 +206,5 @@ func (r *ConvAIAgentResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *ConvAIAgentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
 }
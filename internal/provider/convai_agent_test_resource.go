@@ -3,11 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
@@ -15,9 +20,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &ConvAIAgentTestResource{}
-	_ resource.ResourceWithConfigure   = &ConvAIAgentTestResource{}
-	_ resource.ResourceWithImportState = &ConvAIAgentTestResource{}
+	_ resource.Resource                   = &ConvAIAgentTestResource{}
+	_ resource.ResourceWithConfigure      = &ConvAIAgentTestResource{}
+	_ resource.ResourceWithImportState    = &ConvAIAgentTestResource{}
+	_ resource.ResourceWithValidateConfig = &ConvAIAgentTestResource{}
 )
 
 func NewConvAIAgentTestResource() resource.Resource {
@@ -29,9 +35,37 @@ type ConvAIAgentTestResource struct {
 }
 
 type ConvAIAgentTestResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	SuccessCondition types.String `tfsdk:"success_condition"`
+	ID               types.String                      `tfsdk:"id"`
+	Name             types.String                      `tfsdk:"name"`
+	Type             types.String                      `tfsdk:"type"`
+	ChatHistory      []ConvAIAgentTestChatMessageModel `tfsdk:"chat_history"`
+	SuccessCondition types.String                      `tfsdk:"success_condition"`
+	SuccessExamples  types.List                        `tfsdk:"success_examples"`
+	FailureExamples  types.List                        `tfsdk:"failure_examples"`
+	ToolCall         *ConvAIAgentTestToolCallModel     `tfsdk:"tool_call"`
+	DynamicVariables types.Map                         `tfsdk:"dynamic_variables"`
+	Workspace        types.String                      `tfsdk:"workspace"`
+}
+
+type ConvAIAgentTestChatMessageModel struct {
+	Role           types.String `tfsdk:"role"`
+	Message        types.String `tfsdk:"message"`
+	TimeInCallSecs types.Int64  `tfsdk:"time_in_call_secs"`
+}
+
+type ConvAIAgentTestToolCallModel struct {
+	ToolID        types.String                            `tfsdk:"tool_id"`
+	ToolType      types.String                            `tfsdk:"tool_type"`
+	VerifyAbsence types.Bool                              `tfsdk:"verify_absence"`
+	Parameters    []ConvAIAgentTestToolCallParameterModel `tfsdk:"parameters"`
+}
+
+type ConvAIAgentTestToolCallParameterModel struct {
+	Path          types.String `tfsdk:"path"`
+	Type          types.String `tfsdk:"type"`
+	ExpectedValue types.String `tfsdk:"expected_value"`
+	Pattern       types.String `tfsdk:"pattern"`
+	Description   types.String `tfsdk:"description"`
 }
 
 func (r *ConvAIAgentTestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,9 +85,99 @@ func (r *ConvAIAgentTestResource) Schema(ctx context.Context, req resource.Schem
 			"name": schema.StringAttribute{
 				Required: true,
 			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("llm"),
+				MarkdownDescription: "`llm` to evaluate the agent's response against `success_condition`, or `tool` to check the tool call in `tool_call`. Defaults to `llm`.",
+			},
+			"chat_history": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The conversation so far. The agent's next response is evaluated.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "`user` or `agent`.",
+						},
+						"message": schema.StringAttribute{
+							Optional: true,
+						},
+						"time_in_call_secs": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(0),
+							MarkdownDescription: "When the message was sent, in seconds since the call started.",
+						},
+					},
+				},
+			},
 			"success_condition": schema.StringAttribute{
 				Required: true,
 			},
+			"success_examples": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Up to 5 example responses that pass the test.",
+			},
+			"failure_examples": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Up to 5 example responses that fail the test.",
+			},
+			"tool_call": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The tool call expected in the agent's response. Required when `type` is `tool`.",
+				Attributes: map[string]schema.Attribute{
+					"tool_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"tool_type": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "`system`, `webhook`, `client`, `workflow` or `api_integration_webhook`. Required with `tool_id`.",
+					},
+					"verify_absence": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Pass only when the tool is not called.",
+					},
+					"parameters": schema.ListNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Checks on the parameters of the tool call.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"path": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "Path of the parameter, e.g. `order.id`.",
+								},
+								"type": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "`exact`, `regex`, `llm` or `anything`.",
+								},
+								"expected_value": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "Value to match when `type` is `exact`.",
+								},
+								"pattern": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "Regular expression to match when `type` is `regex`.",
+								},
+								"description": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "What the LLM checks when `type` is `llm`.",
+								},
+							},
+						},
+					},
+				},
+			},
+			"dynamic_variables": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Dynamic variables to use when running the test.",
+			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -82,15 +206,18 @@ func (r *ConvAIAgentTestResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	addReq := &models.CreateConvAIAgentTestRequest{
-		Name:             data.Name.ValueString(),
-		SuccessCondition: data.SuccessCondition.ValueString(),
-		ChatHistory:      []interface{}{}, // Placeholder for simplicity
-		SuccessExamples:  []string{},
-		FailureExamples:  []string{},
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addReq := expandConvAIAgentTest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	test, err := r.client.CreateConvAIAgentTest(addReq)
+	test, err := c.CreateConvAIAgentTest(addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating ConvAI agent test", err.Error())
 		return
@@ -108,20 +235,51 @@ func (r *ConvAIAgentTestResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	test, err := r.client.GetConvAIAgentTest(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	test, err := c.GetConvAIAgentTest(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ConvAI agent test", err.Error())
 		return
 	}
 
-	data.Name = types.StringValue(test.Name)
-	data.SuccessCondition = types.StringValue(test.SuccessCondition)
+	applyConvAIAgentTestToState(ctx, &data, test, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ConvAIAgentTestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning("Update limited", "Updating ConvAI agent tests might require replacement.")
+	var data ConvAIAgentTestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := expandConvAIAgentTest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := c.UpdateConvAIAgentTest(data.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating ConvAI agent test", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ConvAIAgentTestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -131,7 +289,13 @@ func (r *ConvAIAgentTestResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := r.client.DeleteConvAIAgentTest(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIAgentTest(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI agent test", err.Error())
 		return
@@ -141,3 +305,238 @@ func (r *ConvAIAgentTestResource) Delete(ctx context.Context, req resource.Delet
 func (r *ConvAIAgentTestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *ConvAIAgentTestResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// chat_history and tool_call decode into plain structs, which cannot hold
+	// unknown values; a definition built from other resources is checked by
+	// the API instead.
+	if !rootAttributesKnown(req.Config.Raw, "chat_history", "tool_call") {
+		return
+	}
+
+	var data ConvAIAgentTestResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateConvAIAgentTest(ctx, &data, &resp.Diagnostics)
+}
+
+// validateConvAIAgentTest checks the rules the API enforces on a test
+// definition, so they surface at plan time rather than on create or update.
+func validateConvAIAgentTest(ctx context.Context, data *ConvAIAgentTestResourceModel, diags *diag.Diagnostics) {
+	if !data.Type.IsUnknown() {
+		switch testType := data.Type.ValueString(); testType {
+		case "", "llm":
+		case "tool":
+			if data.ToolCall == nil {
+				diags.AddAttributeError(path.Root("tool_call"), "Invalid Configuration", "tool_call is required when type is \"tool\".")
+			}
+		default:
+			diags.AddAttributeError(path.Root("type"), "Invalid Configuration", fmt.Sprintf("type must be llm or tool, got %q.", testType))
+		}
+	}
+
+	if len(data.ChatHistory) == 0 {
+		diags.AddAttributeError(path.Root("chat_history"), "Invalid Configuration", "chat_history needs at least one message.")
+	}
+	for i, message := range data.ChatHistory {
+		role := message.Role.ValueString()
+		if role != "user" && role != "agent" {
+			diags.AddAttributeError(path.Root("chat_history").AtListIndex(i).AtName("role"), "Invalid Configuration", fmt.Sprintf("role must be user or agent, got %q.", role))
+		}
+	}
+
+	for attribute, list := range map[string]types.List{
+		"success_examples": data.SuccessExamples,
+		"failure_examples": data.FailureExamples,
+	} {
+		if !list.IsNull() && !list.IsUnknown() && len(list.Elements()) > 5 {
+			diags.AddAttributeError(path.Root(attribute), "Invalid Configuration", fmt.Sprintf("%s takes at most 5 examples.", attribute))
+		}
+	}
+
+	if data.ToolCall == nil {
+		return
+	}
+
+	if !data.ToolCall.ToolID.IsNull() && data.ToolCall.ToolType.IsNull() {
+		diags.AddAttributeError(path.Root("tool_call").AtName("tool_type"), "Invalid Configuration", "tool_type is required with tool_id.")
+	}
+
+	for i, parameter := range data.ToolCall.Parameters {
+		attrPath := path.Root("tool_call").AtName("parameters").AtListIndex(i)
+		switch parameterType := parameter.Type.ValueString(); parameterType {
+		case "exact":
+			if parameter.ExpectedValue.IsNull() {
+				diags.AddAttributeError(attrPath.AtName("expected_value"), "Invalid Configuration", "expected_value is required when type is \"exact\".")
+			}
+		case "regex":
+			if parameter.Pattern.IsNull() {
+				diags.AddAttributeError(attrPath.AtName("pattern"), "Invalid Configuration", "pattern is required when type is \"regex\".")
+			}
+		case "llm":
+			if parameter.Description.IsNull() {
+				diags.AddAttributeError(attrPath.AtName("description"), "Invalid Configuration", "description is required when type is \"llm\".")
+			}
+		case "anything":
+		default:
+			diags.AddAttributeError(attrPath.AtName("type"), "Invalid Configuration", fmt.Sprintf("type must be exact, regex, llm or anything, got %q.", parameterType))
+		}
+	}
+}
+
+// expandConvAIAgentTest builds the test definition sent on create and update,
+// which both take the whole definition.
+func expandConvAIAgentTest(ctx context.Context, data *ConvAIAgentTestResourceModel, diags *diag.Diagnostics) *models.CreateConvAIAgentTestRequest {
+	testReq := &models.CreateConvAIAgentTestRequest{
+		Name:             data.Name.ValueString(),
+		Type:             data.Type.ValueString(),
+		SuccessCondition: data.SuccessCondition.ValueString(),
+		ChatHistory:      []models.ConvAIAgentTestChatMessage{},
+		SuccessExamples:  []models.ConvAIAgentTestResponseExample{},
+		FailureExamples:  []models.ConvAIAgentTestResponseExample{},
+	}
+
+	for _, message := range data.ChatHistory {
+		testReq.ChatHistory = append(testReq.ChatHistory, models.ConvAIAgentTestChatMessage{
+			Role:           message.Role.ValueString(),
+			Message:        stringPointerFromValue(message.Message),
+			TimeInCallSecs: message.TimeInCallSecs.ValueInt64(),
+		})
+	}
+
+	for _, examples := range []struct {
+		list types.List
+		kind string
+		dst  *[]models.ConvAIAgentTestResponseExample
+	}{
+		{data.SuccessExamples, "success", &testReq.SuccessExamples},
+		{data.FailureExamples, "failure", &testReq.FailureExamples},
+	} {
+		responses, d := stringSliceFromList(ctx, examples.list)
+		diags.Append(d...)
+		for _, response := range responses {
+			*examples.dst = append(*examples.dst, models.ConvAIAgentTestResponseExample{Response: response, Type: examples.kind})
+		}
+	}
+
+	if data.ToolCall != nil {
+		testReq.ToolCallParameters = expandConvAIAgentTestToolCall(data.ToolCall)
+	}
+
+	if !data.DynamicVariables.IsNull() && !data.DynamicVariables.IsUnknown() {
+		variables := map[string]string{}
+		diags.Append(data.DynamicVariables.ElementsAs(ctx, &variables, false)...)
+		testReq.DynamicVariables = map[string]interface{}{}
+		for name, value := range variables {
+			testReq.DynamicVariables[name] = value
+		}
+	}
+
+	return testReq
+}
+
+func expandConvAIAgentTestToolCall(toolCall *ConvAIAgentTestToolCallModel) *models.ConvAIAgentTestToolCallEvaluation {
+	evaluation := &models.ConvAIAgentTestToolCallEvaluation{
+		Parameters:    []models.ConvAIAgentTestToolCallParameter{},
+		VerifyAbsence: toolCall.VerifyAbsence.ValueBool(),
+	}
+
+	if !toolCall.ToolID.IsNull() {
+		evaluation.ReferencedTool = &models.ConvAIReferencedTool{
+			ID:   toolCall.ToolID.ValueString(),
+			Type: toolCall.ToolType.ValueString(),
+		}
+	}
+
+	for _, parameter := range toolCall.Parameters {
+		eval := models.ConvAIAgentTestParameterEval{Type: parameter.Type.ValueString()}
+		switch eval.Type {
+		case "exact":
+			eval.ExpectedValue = parameter.ExpectedValue.ValueString()
+		case "regex":
+			eval.Pattern = parameter.Pattern.ValueString()
+		case "llm":
+			eval.Description = parameter.Description.ValueString()
+		}
+		evaluation.Parameters = append(evaluation.Parameters, models.ConvAIAgentTestToolCallParameter{
+			Path: parameter.Path.ValueString(),
+			Eval: eval,
+		})
+	}
+
+	return evaluation
+}
+
+func applyConvAIAgentTestToState(ctx context.Context, data *ConvAIAgentTestResourceModel, test *models.ConvAIAgentTest, diags *diag.Diagnostics) {
+	data.Name = types.StringValue(test.Name)
+	data.SuccessCondition = types.StringValue(test.SuccessCondition)
+	if test.Type != "" {
+		data.Type = types.StringValue(test.Type)
+	} else {
+		data.Type = types.StringValue("llm")
+	}
+
+	data.ChatHistory = make([]ConvAIAgentTestChatMessageModel, 0, len(test.ChatHistory))
+	for _, message := range test.ChatHistory {
+		data.ChatHistory = append(data.ChatHistory, ConvAIAgentTestChatMessageModel{
+			Role:           types.StringValue(message.Role),
+			Message:        stringValueOrNull(message.Message),
+			TimeInCallSecs: types.Int64Value(message.TimeInCallSecs),
+		})
+	}
+
+	var d diag.Diagnostics
+	data.SuccessExamples, d = stringsToListValue(ctx, convAIAgentTestExampleResponses(test.SuccessExamples))
+	diags.Append(d...)
+	data.FailureExamples, d = stringsToListValue(ctx, convAIAgentTestExampleResponses(test.FailureExamples))
+	diags.Append(d...)
+
+	data.ToolCall = nil
+	if test.ToolCallParameters != nil {
+		toolCall := &ConvAIAgentTestToolCallModel{
+			ToolID:        types.StringNull(),
+			ToolType:      types.StringNull(),
+			VerifyAbsence: types.BoolValue(test.ToolCallParameters.VerifyAbsence),
+		}
+		if tool := test.ToolCallParameters.ReferencedTool; tool != nil {
+			toolCall.ToolID = types.StringValue(tool.ID)
+			toolCall.ToolType = types.StringValue(tool.Type)
+		}
+		for _, parameter := range test.ToolCallParameters.Parameters {
+			toolCall.Parameters = append(toolCall.Parameters, ConvAIAgentTestToolCallParameterModel{
+				Path:          types.StringValue(parameter.Path),
+				Type:          types.StringValue(parameter.Eval.Type),
+				ExpectedValue: optionalStringValue(parameter.Eval.ExpectedValue),
+				Pattern:       optionalStringValue(parameter.Eval.Pattern),
+				Description:   optionalStringValue(parameter.Eval.Description),
+			})
+		}
+		data.ToolCall = toolCall
+	}
+
+	// The API also accepts numbers and booleans; they are read back as strings.
+	variables := map[string]string{}
+	for name, value := range test.DynamicVariables {
+		switch v := value.(type) {
+		case nil:
+		case string:
+			variables[name] = v
+		case float64:
+			variables[name] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			variables[name] = fmt.Sprint(v)
+		}
+	}
+	data.DynamicVariables = stringMapValueOrNull(ctx, variables, diags)
+}
+
+func convAIAgentTestExampleResponses(examples []models.ConvAIAgentTestResponseExample) []string {
+	responses := make([]string, 0, len(examples))
+	for _, example := range examples {
+		responses = append(responses, example.Response)
+	}
+	return responses
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+func TestExpandConvAIAgentTest(t *testing.T) {
+	ctx := context.Background()
+	data := &ConvAIAgentTestResourceModel{
+		Name:             types.StringValue("Refund lookup"),
+		Type:             types.StringValue("tool"),
+		SuccessCondition: types.StringValue("The agent looks up the order"),
+		ChatHistory: []ConvAIAgentTestChatMessageModel{
+			{Role: types.StringValue("user"), Message: types.StringValue("Where is order 42?"), TimeInCallSecs: types.Int64Value(3)},
+		},
+		SuccessExamples:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Let me check order 42.")}),
+		FailureExamples:  types.ListNull(types.StringType),
+		DynamicVariables: types.MapValueMust(types.StringType, map[string]attr.Value{"customer_tier": types.StringValue("gold")}),
+		ToolCall: &ConvAIAgentTestToolCallModel{
+			ToolID:        types.StringValue("tool-123"),
+			ToolType:      types.StringValue("webhook"),
+			VerifyAbsence: types.BoolValue(false),
+			Parameters: []ConvAIAgentTestToolCallParameterModel{
+				{Path: types.StringValue("order_id"), Type: types.StringValue("exact"), ExpectedValue: types.StringValue("42")},
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+	testReq := expandConvAIAgentTest(ctx, data, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	body, err := json.Marshal(testReq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"name":"Refund lookup","type":"tool","chat_history":[{"role":"user","message":"Where is order 42?","time_in_call_secs":3}],"success_condition":"The agent looks up the order","success_examples":[{"response":"Let me check order 42.","type":"success"}],"failure_examples":[],"tool_call_parameters":{"parameters":[{"path":"order_id","eval":{"type":"exact","expected_value":"42"}}],"referenced_tool":{"id":"tool-123","type":"webhook"},"verify_absence":false},"dynamic_variables":{"customer_tier":"gold"}}`
+	if string(body) != want {
+		t.Errorf("Unexpected request body:\n got: %s\nwant: %s", body, want)
+	}
+
+}
+
+func TestValidateConvAIAgentTest(t *testing.T) {
+	ctx := context.Background()
+	valid := func() *ConvAIAgentTestResourceModel {
+		return &ConvAIAgentTestResourceModel{
+			Type: types.StringValue("tool"),
+			ChatHistory: []ConvAIAgentTestChatMessageModel{
+				{Role: types.StringValue("user"), Message: types.StringValue("Where is order 42?")},
+			},
+			SuccessExamples: types.ListNull(types.StringType),
+			FailureExamples: types.ListNull(types.StringType),
+			ToolCall: &ConvAIAgentTestToolCallModel{
+				ToolID:   types.StringValue("tool-123"),
+				ToolType: types.StringValue("webhook"),
+				Parameters: []ConvAIAgentTestToolCallParameterModel{
+					{Path: types.StringValue("order_id"), Type: types.StringValue("exact"), ExpectedValue: types.StringValue("42")},
+				},
+			},
+		}
+	}
+
+	tooMany := make([]attr.Value, 6)
+	for i := range tooMany {
+		tooMany[i] = types.StringValue(fmt.Sprintf("example %d", i))
+	}
+
+	tests := map[string]struct {
+		modify func(*ConvAIAgentTestResourceModel)
+		want   string
+	}{
+		"valid":                  {func(*ConvAIAgentTestResourceModel) {}, ""},
+		"unknown type":           {func(d *ConvAIAgentTestResourceModel) { d.Type = types.StringUnknown() }, ""},
+		"bad type":               {func(d *ConvAIAgentTestResourceModel) { d.Type = types.StringValue("audio") }, "type must be llm or tool"},
+		"tool without tool_call": {func(d *ConvAIAgentTestResourceModel) { d.ToolCall = nil }, "tool_call is required"},
+		"empty chat_history":     {func(d *ConvAIAgentTestResourceModel) { d.ChatHistory = nil }, "at least one message"},
+		"bad role":               {func(d *ConvAIAgentTestResourceModel) { d.ChatHistory[0].Role = types.StringValue("system") }, "role must be user or agent"},
+		"too many examples": {func(d *ConvAIAgentTestResourceModel) {
+			d.FailureExamples = types.ListValueMust(types.StringType, tooMany)
+		}, "at most 5 examples"},
+		"tool_id without tool_type": {func(d *ConvAIAgentTestResourceModel) { d.ToolCall.ToolType = types.StringNull() }, "tool_type is required"},
+		"regex without pattern": {func(d *ConvAIAgentTestResourceModel) {
+			d.ToolCall.Parameters[0].Type = types.StringValue("regex")
+		}, "pattern is required"},
+		"bad parameter type": {func(d *ConvAIAgentTestResourceModel) {
+			d.ToolCall.Parameters[0].Type = types.StringValue("fuzzy")
+		}, "type must be exact, regex, llm or anything"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := valid()
+			tt.modify(data)
+
+			var diags diag.Diagnostics
+			validateConvAIAgentTest(ctx, data, &diags)
+			if tt.want == "" {
+				if diags.HasError() {
+					t.Fatalf("Unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tt.want) {
+				t.Errorf("Expected an error containing %q, got %v", tt.want, diags)
+			}
+		})
+	}
+}
+
+func TestConvAIAgentTestResource_ValidateConfig(t *testing.T) {
+	r := NewConvAIAgentTestResource()
+
+	diags := validateResourceConfig(t, r, map[string]tftypes.Value{
+		"type": tftypes.NewValue(tftypes.String, "tool"),
+	})
+	if !diags.HasError() {
+		t.Errorf("Expected errors for a tool test without tool_call or chat_history")
+	}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+	chatHistoryType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object).AttributeTypes["chat_history"]
+	diags = validateResourceConfig(t, r, map[string]tftypes.Value{
+		"type":         tftypes.NewValue(tftypes.String, "llm"),
+		"chat_history": tftypes.NewValue(chatHistoryType, tftypes.UnknownValue),
+	})
+	if diags.HasError() {
+		t.Errorf("Unexpected diagnostics for an unknown chat_history: %v", diags)
+	}
+}
+
+func TestAccConvAIAgentTestResourceUpdate(t *testing.T) {
+	var mu sync.Mutex
+	var stored models.ConvAIAgentTest
+	puts := 0
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			var body models.CreateConvAIAgentTestRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if r.Method == http.MethodPut {
+				puts++
+			}
+			stored = models.ConvAIAgentTest{
+				TestID:             "test-123",
+				Name:               body.Name,
+				Type:               body.Type,
+				ChatHistory:        body.ChatHistory,
+				SuccessCondition:   body.SuccessCondition,
+				SuccessExamples:    body.SuccessExamples,
+				FailureExamples:    body.FailureExamples,
+				ToolCallParameters: body.ToolCallParameters,
+				DynamicVariables:   body.DynamicVariables,
+			}
+		case http.MethodDelete:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(stored)
+	}
+
+	server := newTestServer(t, []testRoute{
+		{Method: httpMethodPost, Path: "/convai/agent-testing/create", Handler: handler},
+		{Method: httpMethodGet, Path: "/convai/agent-testing/test-123", Handler: handler},
+		{Method: http.MethodPut, Path: "/convai/agent-testing/test-123", Handler: handler},
+		{Method: httpMethodDelete, Path: "/convai/agent-testing/test-123", Handler: handler},
+		{
+			Method: httpMethodPost,
+			Path:   "/convai/agent-testing/summaries",
+			Body:   `{"tests":{"test-123":{"id":"test-123","name":"Greeting","type":"llm","created_at_unix_secs":1700000000,"last_updated_at_unix_secs":1700000100}}}`,
+		},
+	})
+	defer server.Close()
+
+	config := func(condition string) string {
+		return fmt.Sprintf(`
+%s
+
+resource "elevenlabs_convai_agent_test" "greeting" {
+  name              = "Greeting"
+  success_condition = %q
+  success_examples  = ["Hello! How can I help?"]
+
+  chat_history = [
+    {
+      role    = "user"
+      message = "Hi"
+    }
+  ]
+
+  dynamic_variables = {
+    customer_name = "Ada"
+  }
+}
+
+data "elevenlabs_convai_agent_test_summaries" "all" {
+  test_ids = [elevenlabs_convai_agent_test.greeting.id, "missing"]
+}
+`, testAccProviderConfig(server.URL), condition)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("The agent greets the user"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_convai_agent_test.greeting", "id", "test-123"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_agent_test.greeting", "type", "llm"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_agent_test_summaries.all", "tests.0.name", "Greeting"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_agent_test_summaries.all", "missing_test_ids.0", "missing"),
+				),
+			},
+			{
+				Config: config("The agent greets the user by name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_convai_agent_test.greeting", "id", "test-123"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_agent_test.greeting", "success_condition", "The agent greets the user by name"),
+					func(_ *terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+						if puts != 1 {
+							return fmt.Errorf("expected the test to be updated in place once, got %d PUT requests", puts)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "elevenlabs_convai_agent_test.greeting",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"workspace"},
+			},
+		},
+	})
+}
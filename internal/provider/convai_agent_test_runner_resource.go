@@ -33,6 +33,7 @@ type ConvAIAgentTestRunnerResourceModel struct {
 	Results          types.List     `tfsdk:"results"`
 	StartedAt        types.String   `tfsdk:"started_at"`
 	CompletedAt      types.String   `tfsdk:"completed_at"`
+	Workspace        types.String   `tfsdk:"workspace"`
 }
 
 type TestResultModel struct {
@@ -101,6 +102,7 @@ func (r *ConvAIAgentTestRunnerResource) Schema(ctx context.Context, req resource
 			"completed_at": schema.StringAttribute{
 				Computed: true,
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -129,6 +131,12 @@ func (r *ConvAIAgentTestRunnerResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, plan.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	testIDs := make([]string, len(plan.TestIDs))
 	for i, testID := range plan.TestIDs {
 		testIDs[i] = testID.ValueString()
@@ -143,7 +151,7 @@ func (r *ConvAIAgentTestRunnerResource) Create(ctx context.Context, req resource
 		}
 	}
 
-	result, err := r.client.RunConvAIAgentTests(plan.AgentID.ValueString(), testIDs, agentConfig)
+	result, err := c.RunConvAIAgentTests(plan.AgentID.ValueString(), testIDs, agentConfig)
 	if err != nil {
 		resp.Diagnostics.AddError("Error running agent tests", err.Error())
 		return
@@ -198,6 +206,12 @@ func (r *ConvAIAgentTestRunnerResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, plan.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	testIDs := make([]string, len(plan.TestIDs))
 	for i, testID := range plan.TestIDs {
 		testIDs[i] = testID.ValueString()
@@ -212,7 +226,7 @@ func (r *ConvAIAgentTestRunnerResource) Update(ctx context.Context, req resource
 		}
 	}
 
-	result, err := r.client.RunConvAIAgentTests(plan.AgentID.ValueString(), testIDs, agentConfig)
+	result, err := c.RunConvAIAgentTests(plan.AgentID.ValueString(), testIDs, agentConfig)
 	if err != nil {
 		resp.Diagnostics.AddError("Error running agent tests", err.Error())
 		return
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &ConvAIAgentTestSummariesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConvAIAgentTestSummariesDataSource{}
+)
+
+func NewConvAIAgentTestSummariesDataSource() datasource.DataSource {
+	return &ConvAIAgentTestSummariesDataSource{}
+}
+
+type ConvAIAgentTestSummariesDataSource struct {
+	client *client.Client
+}
+
+type ConvAIAgentTestSummariesDataSourceModel struct {
+	TestIDs        []types.String                `tfsdk:"test_ids"`
+	Tests          []ConvAIAgentTestSummaryModel `tfsdk:"tests"`
+	MissingTestIDs []types.String                `tfsdk:"missing_test_ids"`
+}
+
+type ConvAIAgentTestSummaryModel struct {
+	TestID                types.String `tfsdk:"test_id"`
+	Name                  types.String `tfsdk:"name"`
+	Type                  types.String `tfsdk:"type"`
+	CreatedAtUnixSecs     types.Int64  `tfsdk:"created_at_unix_secs"`
+	LastUpdatedAtUnixSecs types.Int64  `tfsdk:"last_updated_at_unix_secs"`
+}
+
+func (d *ConvAIAgentTestSummariesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_convai_agent_test_summaries"
+}
+
+func (d *ConvAIAgentTestSummariesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the summaries of ConvAI agent tests by ID.",
+		Attributes: map[string]schema.Attribute{
+			"test_ids": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the tests to look up.",
+			},
+			"tests": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Summaries of the tests that were found, in the order of `test_ids`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "`llm` or `tool`.",
+						},
+						"created_at_unix_secs": schema.Int64Attribute{
+							Computed: true,
+						},
+						"last_updated_at_unix_secs": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"missing_test_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs in `test_ids` that were not found.",
+			},
+		},
+	}
+}
+
+func (d *ConvAIAgentTestSummariesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConvAIAgentTestSummariesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConvAIAgentTestSummariesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	testIDs := make([]string, 0, len(data.TestIDs))
+	for _, testID := range data.TestIDs {
+		testIDs = append(testIDs, testID.ValueString())
+	}
+
+	summaries, err := d.client.GetConvAIAgentTestSummaries(testIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching ConvAI agent test summaries", err.Error())
+		return
+	}
+
+	data.Tests = []ConvAIAgentTestSummaryModel{}
+	data.MissingTestIDs = []types.String{}
+	for _, testID := range testIDs {
+		summary, ok := summaries[testID]
+		if !ok {
+			data.MissingTestIDs = append(data.MissingTestIDs, types.StringValue(testID))
+			continue
+		}
+		data.Tests = append(data.Tests, ConvAIAgentTestSummaryModel{
+			TestID:                types.StringValue(testID),
+			Name:                  types.StringValue(summary.Name),
+			Type:                  types.StringValue(summary.Type),
+			CreatedAtUnixSecs:     types.Int64Value(summary.CreatedAtUnixSecs),
+			LastUpdatedAtUnixSecs: types.Int64Value(summary.LastUpdatedAtUnixSecs),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -26,6 +26,7 @@ type ConvAIConversationResourceModel struct {
 	AgentID        types.String `tfsdk:"agent_id"`
 	Name           types.String `tfsdk:"name"`
 	CreatedAt      types.String `tfsdk:"created_at"`
+	Workspace      types.String `tfsdk:"workspace"`
 }
 
 func (r *ConvAIConversationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -56,6 +57,7 @@ func (r *ConvAIConversationResource) Schema(ctx context.Context, req resource.Sc
 				Computed:            true,
 				MarkdownDescription: "Creation timestamp of the conversation.",
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -84,7 +86,13 @@ func (r *ConvAIConversationResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	conversation, err := r.client.GetConvAIConversation(data.ConversationID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conversation, err := c.GetConvAIConversation(data.ConversationID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading conversation", err.Error())
 		return
@@ -109,7 +117,13 @@ func (r *ConvAIConversationResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	conversation, err := r.client.GetConvAIConversation(data.ConversationID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conversation, err := c.GetConvAIConversation(data.ConversationID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading conversation", err.Error())
 		return
@@ -137,7 +151,13 @@ func (r *ConvAIConversationResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	err := r.client.DeleteConvAIConversation(data.ConversationID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIConversation(data.ConversationID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting conversation", err.Error())
 		return
@@ -145,6 +165,5 @@ func (r *ConvAIConversationResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *ConvAIConversationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("conversation_id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("conversation_id"), req, resp)
 }
-
@@ -25,11 +25,12 @@ type ConvAIConversationSimulatorResource struct {
 }
 
 type ConvAIConversationSimulatorResourceModel struct {
-	AgentID       types.String            `tfsdk:"agent_id"`
-	ChatHistory   []ChatMessageModel      `tfsdk:"chat_history"`
-	AgentConfig   types.Map               `tfsdk:"agent_configuration"`
-	SimulatedChat types.List              `tfsdk:"simulated_conversation"`
-	Analysis      types.Object            `tfsdk:"analysis"`
+	AgentID       types.String       `tfsdk:"agent_id"`
+	ChatHistory   []ChatMessageModel `tfsdk:"chat_history"`
+	AgentConfig   types.Map          `tfsdk:"agent_configuration"`
+	SimulatedChat types.List         `tfsdk:"simulated_conversation"`
+	Analysis      types.Object       `tfsdk:"analysis"`
+	Workspace     types.String       `tfsdk:"workspace"`
 }
 
 type ChatMessageModel struct {
@@ -111,6 +112,7 @@ func (r *ConvAIConversationSimulatorResource) Schema(ctx context.Context, req re
 					"summary":           types.StringType,
 				},
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -139,6 +141,12 @@ func (r *ConvAIConversationSimulatorResource) Create(ctx context.Context, req re
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, plan.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	plan.SimulatedChat = types.ListNull(simulatedMessageObjectType)
 
 	// Convert chat history
@@ -160,7 +168,7 @@ func (r *ConvAIConversationSimulatorResource) Create(ctx context.Context, req re
 		}
 	}
 
-	result, err := r.client.SimulateConversation(plan.AgentID.ValueString(), chatHistory, agentConfig)
+	result, err := c.SimulateConversation(plan.AgentID.ValueString(), chatHistory, agentConfig)
 	if err != nil {
 		resp.Diagnostics.AddError("Error simulating conversation", err.Error())
 		return
@@ -213,6 +221,12 @@ func (r *ConvAIConversationSimulatorResource) Update(ctx context.Context, req re
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, plan.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	plan.SimulatedChat = types.ListNull(simulatedMessageObjectType)
 
 	// Convert chat history
@@ -234,7 +248,7 @@ func (r *ConvAIConversationSimulatorResource) Update(ctx context.Context, req re
 		}
 	}
 
-	result, err := r.client.SimulateConversation(plan.AgentID.ValueString(), chatHistory, agentConfig)
+	result, err := c.SimulateConversation(plan.AgentID.ValueString(), chatHistory, agentConfig)
 	if err != nil {
 		resp.Diagnostics.AddError("Error simulating conversation", err.Error())
 		return
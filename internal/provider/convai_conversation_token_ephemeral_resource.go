@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &ConvAIConversationTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ConvAIConversationTokenEphemeralResource{}
+)
+
+func NewConvAIConversationTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ConvAIConversationTokenEphemeralResource{}
+}
+
+type ConvAIConversationTokenEphemeralResource struct {
+	client *client.Client
+}
+
+type ConvAIConversationTokenEphemeralResourceModel struct {
+	AgentID         types.String `tfsdk:"agent_id"`
+	ParticipantName types.String `tfsdk:"participant_name"`
+	Token           types.String `tfsdk:"token"`
+	Workspace       types.String `tfsdk:"workspace"`
+}
+
+func (r *ConvAIConversationTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_convai_conversation_token"
+}
+
+func (r *ConvAIConversationTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a WebRTC conversation token for an ElevenLabs ConvAI agent without storing it in state.",
+		Attributes: map[string]schema.Attribute{
+			"agent_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the agent to generate a conversation token for.",
+			},
+			"participant_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Custom participant name. Defaults to the user ID.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The conversation token.",
+			},
+			"workspace": ephemeralWorkspaceAttribute(),
+		},
+	}
+}
+
+func (r *ConvAIConversationTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ConvAIConversationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ConvAIConversationTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := c.GetConvAIConversationToken(data.AgentID.ValueString(), data.ParticipantName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating conversation token", err.Error())
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,556 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+var (
+	_ resource.Resource               = &ConvAIKnowledgeBaseDirectoryResource{}
+	_ resource.ResourceWithConfigure  = &ConvAIKnowledgeBaseDirectoryResource{}
+	_ resource.ResourceWithModifyPlan = &ConvAIKnowledgeBaseDirectoryResource{}
+)
+
+// convAIKnowledgeBaseDirectoryFileAttrTypes are the attributes of each entry
+// in the files map.
+var convAIKnowledgeBaseDirectoryFileAttrTypes = map[string]attr.Type{
+	"document_id":  types.StringType,
+	"content_hash": types.StringType,
+}
+
+func NewConvAIKnowledgeBaseDirectoryResource() resource.Resource {
+	return &ConvAIKnowledgeBaseDirectoryResource{}
+}
+
+type ConvAIKnowledgeBaseDirectoryResource struct {
+	client *client.Client
+}
+
+type ConvAIKnowledgeBaseDirectoryResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Path           types.String `tfsdk:"path"`
+	Include        types.List   `tfsdk:"include"`
+	Exclude        types.List   `tfsdk:"exclude"`
+	ParentFolderID types.String `tfsdk:"parent_folder_id"`
+	Files          types.Map    `tfsdk:"files"`
+	DocumentIDs    types.List   `tfsdk:"document_ids"`
+	Workspace      types.String `tfsdk:"workspace"`
+}
+
+type ConvAIKnowledgeBaseDirectoryFileModel struct {
+	DocumentID  types.String `tfsdk:"document_id"`
+	ContentHash types.String `tfsdk:"content_hash"`
+}
+
+func (r *ConvAIKnowledgeBaseDirectoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_convai_knowledge_base_directory"
+}
+
+func (r *ConvAIKnowledgeBaseDirectoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Syncs the files of a local directory into ConvAI knowledge base documents, uploading only new and changed files.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Random ID assigned on create. It does not change when `path` does.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Local directory to sync. Files are named after their path relative to it.",
+			},
+			"include": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Glob patterns of files to sync. Defaults to every file.",
+			},
+			"exclude": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Glob patterns of files to skip, applied after `include`.",
+			},
+			"parent_folder_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "ID of the knowledge base folder to create the documents in.",
+			},
+			"files": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Synced files, keyed by their relative path.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"document_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"content_hash": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "SHA-256 of the file content that was uploaded.",
+						},
+					},
+				},
+			},
+			"document_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the synced documents, ordered by relative path.",
+			},
+			"workspace": workspaceAttribute(),
+		},
+	}
+}
+
+func (r *ConvAIKnowledgeBaseDirectoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan hashes the matching local files so that only new and changed
+// files get unknown document IDs. Unchanged files keep their documents.
+func (r *ConvAIKnowledgeBaseDirectoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ConvAIKnowledgeBaseDirectoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Path.IsUnknown() || plan.Include.IsUnknown() || plan.Exclude.IsUnknown() {
+		plan.Files = types.MapUnknown(types.ObjectType{AttrTypes: convAIKnowledgeBaseDirectoryFileAttrTypes})
+		plan.DocumentIDs = types.ListUnknown(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	include, diags := stringSliceFromList(ctx, plan.Include)
+	resp.Diagnostics.Append(diags...)
+	exclude, diags := stringSliceFromList(ctx, plan.Exclude)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes, err := scanConvAIKnowledgeBaseDirectory(plan.Path.ValueString(), include, exclude)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Error reading knowledge base directory", err.Error())
+		return
+	}
+
+	prior := map[string]ConvAIKnowledgeBaseDirectoryFileModel{}
+	if !req.State.Raw.IsNull() {
+		var state ConvAIKnowledgeBaseDirectoryResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		resp.Diagnostics.Append(convAIKnowledgeBaseDirectoryFiles(ctx, state.Files, prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	files := map[string]ConvAIKnowledgeBaseDirectoryFileModel{}
+	for rel, hash := range hashes {
+		file := ConvAIKnowledgeBaseDirectoryFileModel{
+			DocumentID:  types.StringUnknown(),
+			ContentHash: types.StringValue(hash),
+		}
+		if existing, ok := prior[rel]; ok && existing.ContentHash.ValueString() == hash {
+			file.DocumentID = existing.DocumentID
+		}
+		files[rel] = file
+	}
+
+	plan.Files, plan.DocumentIDs, diags = convAIKnowledgeBaseDirectoryFilesValue(ctx, files)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ConvAIKnowledgeBaseDirectoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConvAIKnowledgeBaseDirectoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := newRandomID()
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating ID", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+	r.sync(ctx, &data, map[string]ConvAIKnowledgeBaseDirectoryFileModel{}, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ConvAIKnowledgeBaseDirectoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConvAIKnowledgeBaseDirectoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files := map[string]ConvAIKnowledgeBaseDirectoryFileModel{}
+	resp.Diagnostics.Append(convAIKnowledgeBaseDirectoryFiles(ctx, data.Files, files)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Documents deleted outside Terraform are dropped so the next plan
+	// uploads their files again.
+	if len(files) > 0 {
+		existing, err := listConvAIKnowledgeBaseDocumentIDs(c, data.ParentFolderID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing ConvAI knowledge base documents", err.Error())
+			return
+		}
+		for rel, file := range files {
+			if !existing[file.DocumentID.ValueString()] {
+				delete(files, rel)
+			}
+		}
+	}
+
+	data.Files, data.DocumentIDs, diags = convAIKnowledgeBaseDirectoryFilesValue(ctx, files)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConvAIKnowledgeBaseDirectoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ConvAIKnowledgeBaseDirectoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior := map[string]ConvAIKnowledgeBaseDirectoryFileModel{}
+	resp.Diagnostics.Append(convAIKnowledgeBaseDirectoryFiles(ctx, state.Files, prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.sync(ctx, &data, prior, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ConvAIKnowledgeBaseDirectoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConvAIKnowledgeBaseDirectoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files := map[string]ConvAIKnowledgeBaseDirectoryFileModel{}
+	resp.Diagnostics.Append(convAIKnowledgeBaseDirectoryFiles(ctx, data.Files, files)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for rel, file := range files {
+		err := c.DeleteConvAIKnowledgeBase(file.DocumentID.ValueString())
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError("Error deleting ConvAI knowledge base document", fmt.Sprintf("%s: %s", rel, err))
+		}
+	}
+}
+
+// sync makes the documents match the planned files. A new file whose content
+// matches a removed file is treated as a rename and the document is renamed
+// instead of uploaded again. State is saved even when a step fails so that
+// documents already created are not orphaned.
+func (r *ConvAIKnowledgeBaseDirectoryResource) sync(ctx context.Context, data *ConvAIKnowledgeBaseDirectoryResourceModel, prior map[string]ConvAIKnowledgeBaseDirectoryFileModel, state *tfsdk.State, respDiags *diag.Diagnostics) {
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	respDiags.Append(diags...)
+	if respDiags.HasError() {
+		return
+	}
+
+	planned := map[string]ConvAIKnowledgeBaseDirectoryFileModel{}
+	respDiags.Append(convAIKnowledgeBaseDirectoryFiles(ctx, data.Files, planned)...)
+	if respDiags.HasError() {
+		return
+	}
+
+	// Documents whose file was removed, keyed by content hash, are candidates
+	// for renames.
+	removed := map[string][]string{}
+	for rel, file := range prior {
+		if _, ok := planned[rel]; !ok {
+			hash := file.ContentHash.ValueString()
+			removed[hash] = append(removed[hash], rel)
+		}
+	}
+
+	synced := map[string]ConvAIKnowledgeBaseDirectoryFileModel{}
+	var replaced []string
+	var syncErr error
+
+	for _, rel := range sortedKeys(planned) {
+		file := planned[rel]
+		if !file.DocumentID.IsUnknown() {
+			synced[rel] = file
+			continue
+		}
+
+		hash := file.ContentHash.ValueString()
+		if candidates := removed[hash]; len(candidates) > 0 {
+			from := candidates[0]
+			removed[hash] = candidates[1:]
+			documentID := prior[from].DocumentID.ValueString()
+			if _, err := c.UpdateConvAIKnowledgeBase(documentID, &models.UpdateConvAIKnowledgeBaseRequest{Name: rel}); err != nil {
+				syncErr = fmt.Errorf("renaming %s to %s: %w", from, rel, err)
+				synced[from] = prior[from]
+				break
+			}
+			delete(prior, from)
+			synced[rel] = ConvAIKnowledgeBaseDirectoryFileModel{DocumentID: types.StringValue(documentID), ContentHash: file.ContentHash}
+			continue
+		}
+
+		kb, err := c.CreateConvAIKnowledgeBase(&models.CreateConvAIKnowledgeBaseRequest{
+			Name:           rel,
+			FilePath:       filepath.Join(data.Path.ValueString(), filepath.FromSlash(rel)),
+			ParentFolderID: data.ParentFolderID.ValueString(),
+		})
+		if err != nil {
+			syncErr = fmt.Errorf("uploading %s: %w", rel, err)
+			if old, ok := prior[rel]; ok {
+				synced[rel] = old
+			}
+			break
+		}
+		synced[rel] = ConvAIKnowledgeBaseDirectoryFileModel{DocumentID: types.StringValue(kb.DocumentationID), ContentHash: file.ContentHash}
+		if old, ok := prior[rel]; ok {
+			replaced = append(replaced, old.DocumentID.ValueString())
+			delete(prior, rel)
+		}
+	}
+
+	if syncErr == nil {
+		// Whatever is left in prior was removed or replaced.
+		for _, rel := range sortedKeys(prior) {
+			if _, ok := planned[rel]; !ok {
+				replaced = append(replaced, prior[rel].DocumentID.ValueString())
+				delete(prior, rel)
+			}
+		}
+		for _, documentID := range replaced {
+			if err := c.DeleteConvAIKnowledgeBase(documentID); err != nil && !isNotFoundError(err) {
+				syncErr = fmt.Errorf("deleting document %s: %w", documentID, err)
+				break
+			}
+		}
+	} else {
+		// Keep tracking documents that were not cleaned up yet.
+		for rel, file := range prior {
+			if _, ok := synced[rel]; !ok {
+				synced[rel] = file
+			}
+		}
+	}
+
+	data.Files, data.DocumentIDs, diags = convAIKnowledgeBaseDirectoryFilesValue(ctx, synced)
+	respDiags.Append(diags...)
+	if respDiags.HasError() {
+		return
+	}
+
+	respDiags.Append(state.Set(ctx, data)...)
+	if syncErr != nil {
+		respDiags.AddError("Error syncing ConvAI knowledge base directory", syncErr.Error())
+	}
+}
+
+// listConvAIKnowledgeBaseDocumentIDs returns the IDs of the documents in a
+// knowledge base folder, or of every document when parentFolderID is empty.
+func listConvAIKnowledgeBaseDocumentIDs(c *client.Client, parentFolderID string) (map[string]bool, error) {
+	pageSize := 100
+	params := &models.ListConvAIKnowledgeBaseDocumentsParams{
+		PageSize:       &pageSize,
+		ParentFolderID: parentFolderID,
+	}
+
+	ids := map[string]bool{}
+	for {
+		list, err := c.ListConvAIKnowledgeBaseDocuments(params)
+		if err != nil {
+			return nil, err
+		}
+		for _, document := range list.Documents {
+			ids[document.DocumentationID] = true
+		}
+		if !list.HasMore || list.NextCursor == "" {
+			return ids, nil
+		}
+		params.Cursor = list.NextCursor
+	}
+}
+
+// scanConvAIKnowledgeBaseDirectory returns the SHA-256 of every regular file
+// under root that matches include and not exclude, keyed by slash-separated
+// relative path.
+func scanConvAIKnowledgeBaseDirectory(root string, include, exclude []string) (map[string]string, error) {
+	hashes := map[string]string{}
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if len(include) > 0 && !matchesAnyDirectoryPattern(include, rel) {
+			return nil
+		}
+		if matchesAnyDirectoryPattern(exclude, rel) {
+			return nil
+		}
+
+		hash, err := fileSHA256(p)
+		if err != nil {
+			return err
+		}
+		hashes[rel] = hash
+		return nil
+	})
+	return hashes, err
+}
+
+// matchesAnyDirectoryPattern reports whether rel matches one of the patterns.
+// Patterns without a slash match the file name in any directory, patterns
+// ending in "/**" match everything below a directory and other patterns
+// match the whole relative path.
+func matchesAnyDirectoryPattern(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		var matched bool
+		switch {
+		case strings.HasSuffix(pattern, "/**"):
+			matched = strings.HasPrefix(rel, strings.TrimSuffix(pattern, "**"))
+		case !strings.Contains(pattern, "/"):
+			matched, _ = filepath.Match(pattern, filepath.Base(filepath.FromSlash(rel)))
+		default:
+			matched, _ = filepath.Match(filepath.FromSlash(pattern), filepath.FromSlash(rel))
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func fileSHA256(name string) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close() //nolint:errcheck
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// convAIKnowledgeBaseDirectoryFiles decodes a known files map into dst.
+func convAIKnowledgeBaseDirectoryFiles(ctx context.Context, value types.Map, dst map[string]ConvAIKnowledgeBaseDirectoryFileModel) diag.Diagnostics {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ElementsAs(ctx, &dst, false)
+}
+
+// convAIKnowledgeBaseDirectoryFilesValue builds the files map and the
+// document_ids list, which is unknown until every file has a document.
+func convAIKnowledgeBaseDirectoryFilesValue(ctx context.Context, files map[string]ConvAIKnowledgeBaseDirectoryFileModel) (types.Map, types.List, diag.Diagnostics) {
+	filesValue, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: convAIKnowledgeBaseDirectoryFileAttrTypes}, files)
+
+	documentIDs := make([]attr.Value, 0, len(files))
+	for _, rel := range sortedKeys(files) {
+		if files[rel].DocumentID.IsUnknown() {
+			return filesValue, types.ListUnknown(types.StringType), diags
+		}
+		documentIDs = append(documentIDs, files[rel].DocumentID)
+	}
+
+	documentIDsValue, listDiags := types.ListValue(types.StringType, documentIDs)
+	diags.Append(listDiags...)
+	return filesValue, documentIDsValue, diags
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isNotFoundError reports whether err is an API 404 response.
+func isNotFoundError(err error) bool {
+	var apiErr *client.APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
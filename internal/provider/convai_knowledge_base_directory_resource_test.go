@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+)
+
+func writeTestFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestScanConvAIKnowledgeBaseDirectory(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"intro.md":          "intro",
+		"guides/setup.md":   "setup",
+		"guides/notes.txt":  "notes",
+		"drafts/wip.md":     "wip",
+		"guides/img/a.png":  "png",
+		"guides/faq/faq.md": "faq",
+	})
+
+	hashes, err := scanConvAIKnowledgeBaseDirectory(root, []string{"*.md"}, []string{"drafts/**"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := []string{"guides/faq/faq.md", "guides/setup.md", "intro.md"}
+	if got := sortedKeys(hashes); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected files %v, got %v", want, got)
+	}
+	if hashes["intro.md"] != "c432b372e0e30267e65e26a12a42c7957ab52e25dfe3c6d4b929213d88965e45" {
+		t.Errorf("Expected the SHA-256 of intro.md, got %q", hashes["intro.md"])
+	}
+
+	hashes, err = scanConvAIKnowledgeBaseDirectory(root, []string{"guides/*.md"}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if got := sortedKeys(hashes); len(got) != 1 || got[0] != "guides/setup.md" {
+		t.Errorf("Expected only guides/setup.md, got %v", got)
+	}
+}
+
+func TestAccConvAIKnowledgeBaseDirectoryResource(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"billing.md": "How billing works",
+		"refunds.md": "How refunds work",
+		"draft.txt":  "Not ready",
+	})
+
+	var mu sync.Mutex
+	docs := map[string]string{}
+	created := 0
+	var calls []string
+
+	document := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		id := strings.TrimPrefix(r.URL.Path, "/convai/knowledge-base/")
+		name, ok := docs[id]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.Method {
+		case http.MethodPatch:
+			var body struct {
+				Name string `json:"name"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			docs[id] = body.Name
+			name = body.Name
+			calls = append(calls, "rename "+id+" "+name)
+		case http.MethodDelete:
+			delete(docs, id)
+			calls = append(calls, "delete "+id)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"id":%q,"name":%q,"type":"file"}`, id, name)
+	}
+
+	upload := func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		created++
+		id := fmt.Sprintf("kb-%d", created)
+		docs[id] = r.FormValue("name")
+		calls = append(calls, "upload "+id+" "+docs[id])
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"id":%q,"name":%q}`, id, r.FormValue("name"))
+	}
+
+	// The list is served one document per page to exercise pagination.
+	list := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("parent_folder_id") != "folder-1" {
+			http.Error(w, "expected parent_folder_id=folder-1", http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		ids := sortedKeys(docs)
+		index := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			_, _ = fmt.Sscanf(cursor, "page-%d", &index)
+		}
+		page := struct {
+			Documents  []map[string]string `json:"documents"`
+			HasMore    bool                `json:"has_more"`
+			NextCursor string              `json:"next_cursor,omitempty"`
+		}{Documents: []map[string]string{}}
+		if index < len(ids) {
+			page.Documents = append(page.Documents, map[string]string{"id": ids[index], "name": docs[ids[index]], "type": "file"})
+		}
+		if index+1 < len(ids) {
+			page.HasMore = true
+			page.NextCursor = fmt.Sprintf("page-%d", index+1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}
+
+	routes := []testRoute{
+		{Method: httpMethodPost, Path: "/convai/knowledge-base/file", Handler: upload},
+		{Method: httpMethodGet, Path: "/convai/knowledge-base", Handler: list},
+	}
+	for i := 1; i <= 4; i++ {
+		id := fmt.Sprintf("kb-%d", i)
+		for _, method := range []string{httpMethodPatch, httpMethodDelete} {
+			routes = append(routes, testRoute{Method: method, Path: "/convai/knowledge-base/" + id, Handler: document})
+		}
+	}
+
+	server := newTestServer(t, routes)
+	defer server.Close()
+
+	config := func(root string) string {
+		return fmt.Sprintf(`
+%s
+
+resource "elevenlabs_convai_knowledge_base_directory" "support" {
+  path             = %q
+  include          = ["*.md"]
+  parent_folder_id = "folder-1"
+}
+`, testAccProviderConfig(server.URL), root)
+	}
+	moved := filepath.Join(t.TempDir(), "support")
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(root),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["elevenlabs_convai_knowledge_base_directory.support"].Primary.ID
+						return nil
+					},
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base_directory.support", "files.%", "2"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base_directory.support", "files.billing.md.document_id", "kb-1"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base_directory.support", "document_ids.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					// Rename one file, change another and add a new one.
+					if err := os.Rename(filepath.Join(root, "billing.md"), filepath.Join(root, "payments.md")); err != nil {
+						t.Fatal(err)
+					}
+					writeTestFiles(t, root, map[string]string{
+						"refunds.md":  "How refunds work, updated",
+						"shipping.md": "How shipping works",
+					})
+				},
+				Config: config(root),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base_directory.support", "files.%", "3"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base_directory.support", "files.payments.md.document_id", "kb-1"),
+					resource.TestCheckNoResourceAttr("elevenlabs_convai_knowledge_base_directory.support", "files.billing.md.document_id"),
+					func(_ *terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+						if _, ok := docs["kb-2"]; ok {
+							return fmt.Errorf("expected the old refunds.md document to be deleted, calls: %v", calls)
+						}
+						if docs["kb-1"] != "payments.md" {
+							return fmt.Errorf("expected kb-1 to be renamed to payments.md, got %q", docs["kb-1"])
+						}
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					// Moving the directory keeps the resource and its documents.
+					if err := os.Rename(root, moved); err != nil {
+						t.Fatal(err)
+					}
+					mu.Lock()
+					calls = nil
+					mu.Unlock()
+				},
+				Config: config(moved),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources["elevenlabs_convai_knowledge_base_directory.support"].Primary.ID; got != id {
+							return fmt.Errorf("expected the ID to stay %q after moving the directory, got %q", id, got)
+						}
+						mu.Lock()
+						defer mu.Unlock()
+						if len(calls) != 0 {
+							return fmt.Errorf("expected no document changes after moving the directory, got %v", calls)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base_directory.support", "path", moved),
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base_directory.support", "files.%", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestListConvAIKnowledgeBaseDocumentIDs(t *testing.T) {
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/knowledge-base",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				query := r.URL.Query()
+				if query.Get("parent_folder_id") != "folder-1" || query.Get("page_size") != "100" {
+					http.Error(w, "unexpected query "+r.URL.RawQuery, http.StatusBadRequest)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if query.Get("cursor") == "" {
+					_, _ = w.Write([]byte(`{"documents":[{"id":"kb-1","name":"a.md"},{"id":"kb-2","name":"b.md"}],"has_more":true,"next_cursor":"next"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"documents":[{"id":"kb-3","name":"c.md"}],"has_more":false}`))
+			},
+		},
+	})
+	defer server.Close()
+
+	ids, err := listConvAIKnowledgeBaseDocumentIDs(client.NewClient("test-key", server.URL), "folder-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if got := sortedKeys(ids); strings.Join(got, ",") != "kb-1,kb-2,kb-3" {
+		t.Errorf("Expected the documents of both pages, got %v", got)
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil":           {nil, false},
+		"not found":     {&client.APIError{StatusCode: http.StatusNotFound}, true},
+		"wrapped":       {fmt.Errorf("deleting document: %w", &client.APIError{StatusCode: http.StatusNotFound}), true},
+		"other status":  {&client.APIError{StatusCode: http.StatusInternalServerError, Body: "api error (status 404)"}, false},
+		"message match": {fmt.Errorf("api error (status 404): not found"), false},
+	}
+
+	for name, tt := range tests {
+		if got := isNotFoundError(tt.err); got != tt.want {
+			t.Errorf("%s: expected %t, got %t", name, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &ConvAIKnowledgeBaseDocumentDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConvAIKnowledgeBaseDocumentDataSource{}
+)
+
+func NewConvAIKnowledgeBaseDocumentDataSource() datasource.DataSource {
+	return &ConvAIKnowledgeBaseDocumentDataSource{}
+}
+
+type ConvAIKnowledgeBaseDocumentDataSource struct {
+	client *client.Client
+}
+
+type ConvAIKnowledgeBaseDocumentDataSourceModel struct {
+	DocumentationID        types.String                    `tfsdk:"documentation_id"`
+	IncludeContent         types.Bool                      `tfsdk:"include_content"`
+	ChunkIDs               []types.String                  `tfsdk:"chunk_ids"`
+	DependentType          types.String                    `tfsdk:"dependent_type"`
+	Name                   types.String                    `tfsdk:"name"`
+	Type                   types.String                    `tfsdk:"type"`
+	URL                    types.String                    `tfsdk:"url"`
+	FolderParentID         types.String                    `tfsdk:"folder_parent_id"`
+	FolderPath             []types.String                  `tfsdk:"folder_path"`
+	SupportedUsages        []types.String                  `tfsdk:"supported_usages"`
+	SizeBytes              types.Int64                     `tfsdk:"size_bytes"`
+	CreatedAtUnixSecs      types.Int64                     `tfsdk:"created_at_unix_secs"`
+	LastUpdatedAtUnixSecs  types.Int64                     `tfsdk:"last_updated_at_unix_secs"`
+	Content                types.String                    `tfsdk:"content"`
+	SourceFileURL          types.String                    `tfsdk:"source_file_url"`
+	Chunks                 []ConvAIKnowledgeBaseChunkModel `tfsdk:"chunks"`
+	DependentAgentIDs      []types.String                  `tfsdk:"dependent_agent_ids"`
+	InaccessibleAgentCount types.Int64                     `tfsdk:"inaccessible_agent_count"`
+	DependentAgents        []ConvAIDependentAgentModel     `tfsdk:"dependent_agents"`
+}
+
+type ConvAIKnowledgeBaseChunkModel struct {
+	ChunkID types.String `tfsdk:"chunk_id"`
+	Name    types.String `tfsdk:"name"`
+	Content types.String `tfsdk:"content"`
+}
+
+func (d *ConvAIKnowledgeBaseDocumentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_convai_knowledge_base_document"
+}
+
+func (d *ConvAIKnowledgeBaseDocumentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Inspects a ConvAI knowledge base document: its extracted content, RAG chunks, source file and the agents that use it.",
+		Attributes: map[string]schema.Attribute{
+			"documentation_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the document.",
+			},
+			"include_content": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to fetch the extracted content. Defaults to `true`.",
+			},
+			"chunk_ids": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of RAG chunks to fetch.",
+			},
+			"dependent_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Which dependent agents to return: `direct`, `transitive` or `all` (default).",
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "`url`, `file`, `text` or `folder`.",
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Source URL of URL documents.",
+			},
+			"folder_parent_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"folder_path": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the folders containing the document, from the root.",
+			},
+			"supported_usages": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"size_bytes": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at_unix_secs": schema.Int64Attribute{
+				Computed: true,
+			},
+			"last_updated_at_unix_secs": schema.Int64Attribute{
+				Computed: true,
+			},
+			"content": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Content extracted from the document.",
+			},
+			"source_file_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Signed URL to download the uploaded file. Only set for file documents.",
+			},
+			"chunks": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The chunks requested in `chunk_ids`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"chunk_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"content": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dependent_agent_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the dependent agents you can access.",
+			},
+			"inaccessible_agent_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of dependent agents you do not have access to.",
+			},
+			"dependent_agents": dependentAgentsAttribute("Every agent that uses the document."),
+		},
+	}
+}
+
+func (d *ConvAIKnowledgeBaseDocumentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConvAIKnowledgeBaseDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConvAIKnowledgeBaseDocumentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dependentType := data.DependentType.ValueString()
+	switch dependentType {
+	case "", "direct", "transitive", "all":
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("dependent_type"), "Invalid Configuration",
+			fmt.Sprintf("dependent_type must be one of direct, transitive or all, got %q.", dependentType))
+		return
+	}
+
+	documentationID := data.DocumentationID.ValueString()
+	doc, err := d.client.GetConvAIKnowledgeBase(documentationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching ConvAI knowledge base document", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(doc.Name)
+	data.Type = types.StringValue(doc.Type)
+	data.URL = optionalStringValue(doc.URL)
+	data.FolderParentID = optionalStringValue(doc.FolderParentID)
+	data.FolderPath = []types.String{}
+	for _, segment := range doc.FolderPath {
+		data.FolderPath = append(data.FolderPath, types.StringValue(segment.FolderName))
+	}
+	data.SupportedUsages = []types.String{}
+	for _, usage := range doc.SupportedUsages {
+		data.SupportedUsages = append(data.SupportedUsages, types.StringValue(usage))
+	}
+	data.SizeBytes = metadataInt64Value(doc.Metadata, "size_bytes")
+	data.CreatedAtUnixSecs = metadataInt64Value(doc.Metadata, "created_at_unix_secs")
+	data.LastUpdatedAtUnixSecs = metadataInt64Value(doc.Metadata, "last_updated_at_unix_secs")
+
+	data.Content = types.StringNull()
+	if doc.Type != "folder" && (data.IncludeContent.IsNull() || data.IncludeContent.ValueBool()) {
+		content, err := d.client.GetConvAIKnowledgeBaseContent(documentationID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching ConvAI knowledge base document content", err.Error())
+			return
+		}
+		data.Content = types.StringValue(content)
+	}
+
+	data.SourceFileURL = types.StringNull()
+	if doc.Type == "file" {
+		signedURL, err := d.client.GetConvAIKnowledgeBaseSourceFileURL(documentationID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching ConvAI knowledge base source file URL", err.Error())
+			return
+		}
+		data.SourceFileURL = optionalStringValue(signedURL)
+	}
+
+	data.Chunks = make([]ConvAIKnowledgeBaseChunkModel, 0, len(data.ChunkIDs))
+	for _, chunkID := range data.ChunkIDs {
+		chunk, err := d.client.GetConvAIKnowledgeBaseChunk(documentationID, chunkID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching ConvAI knowledge base chunk", err.Error())
+			return
+		}
+		data.Chunks = append(data.Chunks, ConvAIKnowledgeBaseChunkModel{
+			ChunkID: types.StringValue(chunk.ID),
+			Name:    types.StringValue(chunk.Name),
+			Content: types.StringValue(chunk.Content),
+		})
+	}
+
+	agents, err := d.client.GetConvAIKnowledgeBaseDependentAgents(documentationID, dependentType)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching agents using ConvAI knowledge base document", err.Error())
+		return
+	}
+
+	data.DependentAgentIDs = []types.String{}
+	data.DependentAgents = make([]ConvAIDependentAgentModel, 0, len(agents))
+	inaccessible := int64(0)
+	for _, agent := range agents {
+		if agent.ID == "" {
+			inaccessible++
+		} else {
+			data.DependentAgentIDs = append(data.DependentAgentIDs, types.StringValue(agent.ID))
+		}
+		data.DependentAgents = append(data.DependentAgents, flattenConvAIDependentAgent(agent))
+	}
+	data.InaccessibleAgentCount = types.Int64Value(inaccessible)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// metadataInt64Value reads a number from the untyped document metadata.
+func metadataInt64Value(metadata map[string]interface{}, key string) types.Int64 {
+	if value, ok := metadata[key].(float64); ok {
+		return types.Int64Value(int64(value))
+	}
+	return types.Int64Null()
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConvAIKnowledgeBaseDocumentDataSource(t *testing.T) {
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/knowledge-base/kb-file",
+			Body:   `{"id":"kb-file","name":"Handbook","type":"file","supported_usages":["prompt","auto"],"folder_parent_id":"folder-1","folder_path":[{"id":"folder-1","name":"Policies"}],"metadata":{"created_at_unix_secs":1700000000,"last_updated_at_unix_secs":1700003600,"size_bytes":2048}}`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/knowledge-base/kb-file/content",
+			Body:   `<h1>Refund policy</h1><p>Refunds within 30 days.</p>`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/knowledge-base/kb-file/source-file-url",
+			Body:   `{"signed_url":"https://storage.example.com/handbook.pdf?sig=abc"}`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/knowledge-base/kb-file/chunk/chunk-1",
+			Body:   `{"id":"chunk-1","name":"Refund policy","content":"Refunds within 30 days."}`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/knowledge-base/kb-file/dependent-agents",
+			Body:   `{"agents":[{"type":"available","id":"agent-1","name":"Support","created_at_unix_secs":1700000000,"access_level":"admin"},{"type":"unknown"}],"has_more":false}`,
+		},
+	})
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "elevenlabs_convai_knowledge_base_document" "handbook" {
+  documentation_id = "kb-file"
+  chunk_ids        = ["chunk-1"]
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_document.handbook", "name", "Handbook"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_document.handbook", "folder_path.0", "Policies"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_document.handbook", "size_bytes", "2048"),
+					resource.TestMatchResourceAttr("data.elevenlabs_convai_knowledge_base_document.handbook", "content", regexp.MustCompile(`Refunds within 30 days`)),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_document.handbook", "source_file_url", "https://storage.example.com/handbook.pdf?sig=abc"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_document.handbook", "chunks.0.content", "Refunds within 30 days."),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_document.handbook", "dependent_agent_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_document.handbook", "inaccessible_agent_count", "1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_document.handbook", "dependent_agents.1.type", "unknown"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "elevenlabs_convai_knowledge_base_document" "handbook" {
+  documentation_id = "kb-file"
+  dependent_type   = "indirect"
+}
+`, testAccProviderConfig(server.URL)),
+				ExpectError: regexp.MustCompile(`dependent_type must be one of direct, transitive or all`),
+			},
+		},
+	})
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
@@ -16,11 +21,27 @@ import (
 )
 
 var (
-	_ resource.Resource                = &ConvAIKnowledgeBaseRAGIndexResource{}
-	_ resource.ResourceWithConfigure   = &ConvAIKnowledgeBaseRAGIndexResource{}
-	_ resource.ResourceWithImportState = &ConvAIKnowledgeBaseRAGIndexResource{}
+	_ resource.Resource                   = &ConvAIKnowledgeBaseRAGIndexResource{}
+	_ resource.ResourceWithConfigure      = &ConvAIKnowledgeBaseRAGIndexResource{}
+	_ resource.ResourceWithImportState    = &ConvAIKnowledgeBaseRAGIndexResource{}
+	_ resource.ResourceWithValidateConfig = &ConvAIKnowledgeBaseRAGIndexResource{}
 )
 
+// ragIndexPollInterval is how often the index status is checked while waiting
+// for indexing to finish.
+var ragIndexPollInterval = 10 * time.Second
+
+const ragIndexStatusSucceeded = "succeeded"
+
+// ragIndexFailedStatuses are the terminal statuses of an index that will not
+// become ready.
+var ragIndexFailedStatuses = map[string]string{
+	"failed":              "indexing failed",
+	"rag_limit_exceeded":  "the workspace RAG storage limit is exceeded",
+	"document_too_small":  "the document is too small to index",
+	"cannot_index_folder": "folders cannot be indexed",
+}
+
 func NewConvAIKnowledgeBaseRAGIndexResource() resource.Resource {
 	return &ConvAIKnowledgeBaseRAGIndexResource{}
 }
@@ -36,6 +57,9 @@ type ConvAIKnowledgeBaseRAGIndexResourceModel struct {
 	Status             types.String  `tfsdk:"status"`
 	ProgressPercentage types.Float64 `tfsdk:"progress_percentage"`
 	UsedBytes          types.Int64   `tfsdk:"used_bytes"`
+	WaitForReady       types.Bool    `tfsdk:"wait_for_ready"`
+	WaitTimeout        types.String  `tfsdk:"wait_timeout"`
+	Workspace          types.String  `tfsdk:"workspace"`
 }
 
 func (r *ConvAIKnowledgeBaseRAGIndexResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,13 +90,35 @@ func (r *ConvAIKnowledgeBaseRAGIndexResource) Schema(ctx context.Context, req re
 			},
 			"status": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"progress_percentage": schema.Float64Attribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"used_bytes": schema.Int64Attribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Wait until the index status is `succeeded` before completing the apply. Defaults to `true`.",
+			},
+			"wait_timeout": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("20m"),
+				MarkdownDescription: "How long to wait for the index, as a Go duration. Defaults to `20m`.",
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -94,6 +140,18 @@ func (r *ConvAIKnowledgeBaseRAGIndexResource) Configure(ctx context.Context, req
 	r.client = client
 }
 
+func (r *ConvAIKnowledgeBaseRAGIndexResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var waitTimeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("wait_timeout"), &waitTimeout)...)
+	if resp.Diagnostics.HasError() || waitTimeout.IsNull() || waitTimeout.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(waitTimeout.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_timeout"), "Invalid Configuration", fmt.Sprintf("wait_timeout must be a duration such as \"20m\": %s", err))
+	}
+}
+
 func (r *ConvAIKnowledgeBaseRAGIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ConvAIKnowledgeBaseRAGIndexResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -101,19 +159,34 @@ func (r *ConvAIKnowledgeBaseRAGIndexResource) Create(ctx context.Context, req re
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq := &models.RAGIndexRequest{
 		Model: data.Model.ValueString(),
 	}
 
-	index, err := r.client.CreateConvAIKnowledgeBaseRAGIndex(data.DocumentationID.ValueString(), addReq)
+	index, err := c.CreateConvAIKnowledgeBaseRAGIndex(data.DocumentationID.ValueString(), addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating ConvAI knowledge base RAG index", err.Error())
 		return
 	}
 
+	if data.WaitForReady.ValueBool() {
+		// wait_timeout was checked by ValidateConfig.
+		timeout, _ := time.ParseDuration(data.WaitTimeout.ValueString())
+		index, err = waitForRAGIndex(ctx, c, data.DocumentationID.ValueString(), index, timeout)
+	}
 	applyRAGIndexToState(&data, index)
 
+	// The index exists even when it failed, so it is saved and tainted.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for ConvAI knowledge base RAG index", err.Error())
+	}
 }
 
 func (r *ConvAIKnowledgeBaseRAGIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -123,7 +196,13 @@ func (r *ConvAIKnowledgeBaseRAGIndexResource) Read(ctx context.Context, req reso
 		return
 	}
 
-	list, err := r.client.GetConvAIKnowledgeBaseRAGIndexes(data.DocumentationID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := c.GetConvAIKnowledgeBaseRAGIndexes(data.DocumentationID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ConvAI knowledge base RAG indexes", err.Error())
 		return
@@ -157,7 +236,13 @@ func (r *ConvAIKnowledgeBaseRAGIndexResource) Delete(ctx context.Context, req re
 		return
 	}
 
-	err := r.client.DeleteConvAIKnowledgeBaseRAGIndex(data.DocumentationID.ValueString(), data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIKnowledgeBaseRAGIndex(data.DocumentationID.ValueString(), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI knowledge base RAG index", err.Error())
 		return
@@ -165,7 +250,8 @@ func (r *ConvAIKnowledgeBaseRAGIndexResource) Delete(ctx context.Context, req re
 }
 
 func (r *ConvAIKnowledgeBaseRAGIndexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.SplitN(req.ID, ":", 2)
+	id := importStateWorkspace(ctx, req.ID, 1, resp)
+	parts := strings.SplitN(id, ":", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		resp.Diagnostics.AddError("Invalid import ID", "expected ID in format documentation_id:rag_index_id")
 		return
@@ -173,6 +259,8 @@ func (r *ConvAIKnowledgeBaseRAGIndexResource) ImportState(ctx context.Context, r
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("documentation_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_ready"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_timeout"), "20m")...)
 }
 
 func findRAGIndexByID(indexes []models.RAGDocumentIndexResponse, id string) *models.RAGDocumentIndexResponse {
@@ -191,3 +279,36 @@ func applyRAGIndexToState(data *ConvAIKnowledgeBaseRAGIndexResourceModel, index
 	data.ProgressPercentage = types.Float64Value(index.ProgressPercentage)
 	data.UsedBytes = types.Int64Value(index.DocumentModelIndexUsage.UsedBytes)
 }
+
+// waitForRAGIndex polls the document's indexes until the index succeeds, ends
+// in a failed status or the timeout expires.
+func waitForRAGIndex(ctx context.Context, c *client.Client, documentationID string, index *models.RAGDocumentIndexResponse, timeout time.Duration) (*models.RAGDocumentIndexResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		if index.Status == ragIndexStatusSucceeded {
+			return index, nil
+		}
+		if reason, failed := ragIndexFailedStatuses[index.Status]; failed {
+			return index, fmt.Errorf("RAG index %s of document %s has status %q: %s", index.ID, documentationID, index.Status, reason)
+		}
+
+		list, err := c.GetConvAIKnowledgeBaseRAGIndexes(documentationID)
+		if err != nil {
+			return index, err
+		}
+		if current := findRAGIndexByID(list.Indexes, index.ID); current != nil {
+			index = current
+			if index.Status == ragIndexStatusSucceeded || ragIndexFailedStatuses[index.Status] != "" {
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return index, fmt.Errorf("RAG index %s was still %q (%.0f%%) after %s", index.ID, index.Status, index.ProgressPercentage, timeout)
+		case <-time.After(ragIndexPollInterval):
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+func TestWaitForRAGIndex_Succeeds(t *testing.T) {
+	pollInterval := ragIndexPollInterval
+	ragIndexPollInterval = 5 * time.Millisecond
+	defer func() { ragIndexPollInterval = pollInterval }()
+
+	var mu sync.Mutex
+	polls := 0
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/knowledge-base/kb-123/rag-index",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				polls++
+				status, progress := "processing", 50
+				if polls >= 2 {
+					status, progress = "succeeded", 100
+				}
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"indexes":[{"id":"rag-123","model":"e5_mistral_7b_instruct","status":%q,"progress_percentage":%d,"document_model_index_usage":{"used_bytes":2048}}]}`, status, progress)
+			},
+		},
+	})
+	defer server.Close()
+
+	c := client.NewClient("test-key", server.URL)
+	index, err := waitForRAGIndex(context.Background(), c, "kb-123", &models.RAGDocumentIndexResponse{ID: "rag-123", Status: "created"}, time.Second)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if index.Status != "succeeded" || index.DocumentModelIndexUsage.UsedBytes != 2048 {
+		t.Errorf("Expected succeeded index using 2048 bytes, got %+v", index)
+	}
+}
+
+func TestWaitForRAGIndex_Failed(t *testing.T) {
+	pollInterval := ragIndexPollInterval
+	ragIndexPollInterval = 5 * time.Millisecond
+	defer func() { ragIndexPollInterval = pollInterval }()
+
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/knowledge-base/kb-123/rag-index",
+			Body:   `{"indexes":[{"id":"rag-123","model":"e5_mistral_7b_instruct","status":"rag_limit_exceeded","progress_percentage":0}]}`,
+		},
+	})
+	defer server.Close()
+
+	c := client.NewClient("test-key", server.URL)
+	index, err := waitForRAGIndex(context.Background(), c, "kb-123", &models.RAGDocumentIndexResponse{ID: "rag-123", Status: "created"}, time.Second)
+	if err == nil {
+		t.Fatalf("Expected error for failed index")
+	}
+	if !strings.Contains(err.Error(), "rag_limit_exceeded") {
+		t.Errorf("Expected error to name the status, got %q", err)
+	}
+	if index.Status != "rag_limit_exceeded" {
+		t.Errorf("Expected last status 'rag_limit_exceeded', got '%s'", index.Status)
+	}
+}
+
+func TestWaitForRAGIndex_Timeout(t *testing.T) {
+	pollInterval := ragIndexPollInterval
+	ragIndexPollInterval = 5 * time.Millisecond
+	defer func() { ragIndexPollInterval = pollInterval }()
+
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/knowledge-base/kb-123/rag-index",
+			Body:   `{"indexes":[{"id":"rag-123","model":"e5_mistral_7b_instruct","status":"processing","progress_percentage":40}]}`,
+		},
+	})
+	defer server.Close()
+
+	c := client.NewClient("test-key", server.URL)
+	index, err := waitForRAGIndex(context.Background(), c, "kb-123", &models.RAGDocumentIndexResponse{ID: "rag-123", Status: "created"}, 30*time.Millisecond)
+	if err == nil {
+		t.Fatalf("Expected timeout error")
+	}
+	if index.Status != "processing" {
+		t.Errorf("Expected last status 'processing', got '%s'", index.Status)
+	}
+}
+
+func TestConvAIKnowledgeBaseRAGIndexResource_ValidateConfig(t *testing.T) {
+	r := NewConvAIKnowledgeBaseRAGIndexResource()
+
+	for value, wantError := range map[string]bool{"20m": false, "1h30m": false, "twenty minutes": true, "20": true} {
+		diags := validateResourceConfig(t, r, map[string]tftypes.Value{
+			"wait_timeout": tftypes.NewValue(tftypes.String, value),
+		})
+		if diags.HasError() != wantError {
+			t.Errorf("wait_timeout %q: expected error %t, got %v", value, wantError, diags)
+		}
+	}
+
+	diags := validateResourceConfig(t, r, map[string]tftypes.Value{
+		"wait_timeout": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	if diags.HasError() {
+		t.Errorf("Expected an unknown wait_timeout to be accepted, got %v", diags)
+	}
+}
+
+func TestAccConvAIKnowledgeBaseUsageDataSource(t *testing.T) {
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/agent/agent-1/knowledge-base/size",
+			Body:   `{"number_of_pages":12.5}`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/knowledge-base/rag-index",
+			Body:   `{"total_used_bytes":3000,"total_max_bytes":10000,"models":[{"model":"e5_mistral_7b_instruct","used_bytes":3000}]}`,
+		},
+	})
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server.URL) + `
+data "elevenlabs_convai_knowledge_base_usage" "usage" {
+  agent_ids = ["agent-1"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_usage.usage", "agents.0.agent_id", "agent-1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_usage.usage", "agents.0.number_of_pages", "12.5"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_usage.usage", "rag_remaining_bytes", "7000"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_knowledge_base_usage.usage", "rag_models.0.used_bytes", "3000"),
+				),
+			},
+		},
+	})
+}
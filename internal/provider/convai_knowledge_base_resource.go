@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -29,13 +30,17 @@ type ConvAIKnowledgeBaseResource struct {
 }
 
 type ConvAIKnowledgeBaseResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	URL      types.String `tfsdk:"url"`
-	Content  types.String `tfsdk:"content"`
-	FilePath types.String `tfsdk:"file_path"`
-	Type     types.String `tfsdk:"type"`
-	Status   types.String `tfsdk:"status"`
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	URL             types.String   `tfsdk:"url"`
+	Content         types.String   `tfsdk:"content"`
+	FilePath        types.String   `tfsdk:"file_path"`
+	ParentFolderID  types.String   `tfsdk:"parent_folder_id"`
+	RefreshTriggers types.Map      `tfsdk:"refresh_triggers"`
+	Type            types.String   `tfsdk:"type"`
+	Status          types.String   `tfsdk:"status"`
+	FolderPath      []types.String `tfsdk:"folder_path"`
+	Workspace       types.String   `tfsdk:"workspace"`
 }
 
 func (r *ConvAIKnowledgeBaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,7 +49,7 @@ func (r *ConvAIKnowledgeBaseResource) Metadata(ctx context.Context, req resource
 
 func (r *ConvAIKnowledgeBaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Conversational AI Knowledge Base resource for ElevenLabs.",
+		MarkdownDescription: "Conversational AI Knowledge Base document resource for ElevenLabs. Documents can be renamed in place; changing the source or folder creates a new document.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -57,12 +62,36 @@ func (r *ConvAIKnowledgeBaseResource) Schema(ctx context.Context, req resource.S
 			},
 			"url": schema.StringAttribute{
 				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"content": schema.StringAttribute{
 				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"file_path": schema.StringAttribute{
 				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_folder_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the folder to create the document in. The API cannot move documents, so changing this creates a new document.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"refresh_triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that create the document again when they change, for example to crawl a `url` again after the page was published.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
 			},
 			"type": schema.StringAttribute{
 				Computed: true,
@@ -70,6 +99,12 @@ func (r *ConvAIKnowledgeBaseResource) Schema(ctx context.Context, req resource.S
 			"status": schema.StringAttribute{
 				Computed: true,
 			},
+			"folder_path": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the folders containing the document, from the root.",
+			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -98,22 +133,35 @@ func (r *ConvAIKnowledgeBaseResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq := &models.CreateConvAIKnowledgeBaseRequest{
-		Name:     data.Name.ValueString(),
-		URL:      data.URL.ValueString(),
-		Content:  data.Content.ValueString(),
-		FilePath: data.FilePath.ValueString(),
+		Name:           data.Name.ValueString(),
+		URL:            data.URL.ValueString(),
+		Content:        data.Content.ValueString(),
+		FilePath:       data.FilePath.ValueString(),
+		ParentFolderID: data.ParentFolderID.ValueString(),
 	}
 
-	kb, err := r.client.CreateConvAIKnowledgeBase(addReq)
+	created, err := c.CreateConvAIKnowledgeBase(addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating ConvAI knowledge base", err.Error())
 		return
 	}
 
-	data.ID = types.StringValue(kb.DocumentationID)
-	data.Type = types.StringValue(kb.Type)
-	data.Status = types.StringValue(kb.Status)
+	data.ID = types.StringValue(created.DocumentationID)
+
+	// The create endpoints only return the ID and name.
+	kb, err := c.GetConvAIKnowledgeBase(created.DocumentationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ConvAI knowledge base", err.Error())
+		return
+	}
+	applyConvAIKnowledgeBaseToState(&data, kb)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -125,21 +173,53 @@ func (r *ConvAIKnowledgeBaseResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	kb, err := r.client.GetConvAIKnowledgeBase(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kb, err := c.GetConvAIKnowledgeBase(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ConvAI knowledge base", err.Error())
 		return
 	}
 
-	data.Name = types.StringValue(kb.Name)
-	data.Type = types.StringValue(kb.Type)
-	data.Status = types.StringValue(kb.Status)
+	applyConvAIKnowledgeBaseToState(&data, kb)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ConvAIKnowledgeBaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning("Update limited", "Updating ConvAI knowledge base might require replacement.")
+	var data ConvAIKnowledgeBaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other argument forces a new document, so only the name changes here.
+	_, err := c.UpdateConvAIKnowledgeBase(data.ID.ValueString(), &models.UpdateConvAIKnowledgeBaseRequest{
+		Name: data.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating ConvAI knowledge base", err.Error())
+		return
+	}
+
+	kb, err := c.GetConvAIKnowledgeBase(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ConvAI knowledge base", err.Error())
+		return
+	}
+	applyConvAIKnowledgeBaseToState(&data, kb)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ConvAIKnowledgeBaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -149,7 +229,13 @@ func (r *ConvAIKnowledgeBaseResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	err := r.client.DeleteConvAIKnowledgeBase(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIKnowledgeBase(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI knowledge base", err.Error())
 		return
@@ -157,5 +243,22 @@ func (r *ConvAIKnowledgeBaseResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ConvAIKnowledgeBaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
+}
+
+func applyConvAIKnowledgeBaseToState(data *ConvAIKnowledgeBaseResourceModel, kb *models.ConvAIKnowledgeBase) {
+	data.Name = types.StringValue(kb.Name)
+	data.Type = types.StringValue(kb.Type)
+	data.Status = types.StringValue(kb.Status)
+	data.ParentFolderID = optionalStringValue(kb.FolderParentID)
+
+	data.FolderPath = []types.String{}
+	for _, segment := range kb.FolderPath {
+		data.FolderPath = append(data.FolderPath, types.StringValue(segment.FolderName))
+	}
+
+	// Only imported documents take the URL from the API, which may normalize it.
+	if data.URL.IsNull() && kb.URL != "" {
+		data.URL = types.StringValue(kb.URL)
+	}
 }
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+func TestAccConvAIKnowledgeBaseResourceURL(t *testing.T) {
+	var mu sync.Mutex
+	docs := map[string]*models.ConvAIKnowledgeBase{}
+	created := 0
+
+	document := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		doc, ok := docs[strings.TrimPrefix(r.URL.Path, "/convai/knowledge-base/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodPatch {
+			var body models.UpdateConvAIKnowledgeBaseRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			doc.Name = body.Name
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(doc)
+	}
+
+	create := func(w http.ResponseWriter, r *http.Request) {
+		var body models.CreateConvAIKnowledgeBaseRequest
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		created++
+		id := fmt.Sprintf("kb-%d", created)
+		docs[id] = &models.ConvAIKnowledgeBase{
+			DocumentationID: id,
+			Name:            body.Name,
+			Type:            "url",
+			URL:             body.URL,
+			FolderParentID:  body.ParentFolderID,
+			FolderPath:      []models.ConvAIKnowledgeBaseFolderPathSegment{{FolderID: body.ParentFolderID, FolderName: "Product docs"}},
+		}
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": id, "name": body.Name})
+	}
+
+	remove := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		delete(docs, strings.TrimPrefix(r.URL.Path, "/convai/knowledge-base/"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}
+
+	routes := []testRoute{{Method: httpMethodPost, Path: "/convai/knowledge-base/url", Handler: create}}
+	for _, id := range []string{"kb-1", "kb-2"} {
+		routes = append(routes,
+			testRoute{Method: httpMethodGet, Path: "/convai/knowledge-base/" + id, Handler: document},
+			testRoute{Method: httpMethodPatch, Path: "/convai/knowledge-base/" + id, Handler: document},
+			testRoute{Method: httpMethodDelete, Path: "/convai/knowledge-base/" + id, Handler: remove},
+		)
+	}
+
+	server := newTestServer(t, routes)
+	defer server.Close()
+
+	config := func(name, release string) string {
+		return fmt.Sprintf(`
+%s
+
+resource "elevenlabs_convai_knowledge_base" "docs" {
+  name             = %q
+  url              = "https://docs.example.com/"
+  parent_folder_id = "folder-1"
+
+  refresh_triggers = {
+    release = %q
+  }
+}
+`, testAccProviderConfig(server.URL), name, release)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Docs", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base.docs", "id", "kb-1"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base.docs", "type", "url"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base.docs", "folder_path.0", "Product docs"),
+				),
+			},
+			{
+				Config: config("Product docs", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base.docs", "id", "kb-1"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base.docs", "name", "Product docs"),
+				),
+			},
+			{
+				Config: config("Product docs", "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_convai_knowledge_base.docs", "id", "kb-2"),
+				),
+			},
+			{
+				ResourceName:            "elevenlabs_convai_knowledge_base.docs",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"workspace", "refresh_triggers"},
+			},
+		},
+	})
+}
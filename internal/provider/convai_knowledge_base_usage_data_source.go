@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &ConvAIKnowledgeBaseUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConvAIKnowledgeBaseUsageDataSource{}
+)
+
+func NewConvAIKnowledgeBaseUsageDataSource() datasource.DataSource {
+	return &ConvAIKnowledgeBaseUsageDataSource{}
+}
+
+type ConvAIKnowledgeBaseUsageDataSource struct {
+	client *client.Client
+}
+
+type ConvAIKnowledgeBaseUsageDataSourceModel struct {
+	AgentIDs          []types.String                      `tfsdk:"agent_ids"`
+	Agents            []ConvAIAgentKnowledgeBaseSizeModel `tfsdk:"agents"`
+	RAGUsedBytes      types.Int64                         `tfsdk:"rag_used_bytes"`
+	RAGMaxBytes       types.Int64                         `tfsdk:"rag_max_bytes"`
+	RAGRemainingBytes types.Int64                         `tfsdk:"rag_remaining_bytes"`
+	RAGModels         []ConvAIRAGModelUsageModel          `tfsdk:"rag_models"`
+}
+
+type ConvAIAgentKnowledgeBaseSizeModel struct {
+	AgentID       types.String  `tfsdk:"agent_id"`
+	NumberOfPages types.Float64 `tfsdk:"number_of_pages"`
+}
+
+type ConvAIRAGModelUsageModel struct {
+	Model     types.String `tfsdk:"model"`
+	UsedBytes types.Int64  `tfsdk:"used_bytes"`
+}
+
+func (d *ConvAIKnowledgeBaseUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_convai_knowledge_base_usage"
+}
+
+func (d *ConvAIKnowledgeBaseUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the knowledge base size of agents and the RAG index storage used by the workspace.",
+		Attributes: map[string]schema.Attribute{
+			"agent_ids": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Agents to report the knowledge base size of.",
+			},
+			"agents": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Knowledge base size of each agent in `agent_ids`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"agent_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"number_of_pages": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size of the agent's knowledge base in pages.",
+						},
+					},
+				},
+			},
+			"rag_used_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "RAG index storage used by the workspace.",
+			},
+			"rag_max_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "RAG index storage limit of the workspace.",
+			},
+			"rag_remaining_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "RAG index storage left before the limit.",
+			},
+			"rag_models": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "RAG index storage used per embedding model.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"model": schema.StringAttribute{
+							Computed: true,
+						},
+						"used_bytes": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConvAIKnowledgeBaseUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConvAIKnowledgeBaseUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConvAIKnowledgeBaseUsageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Agents = make([]ConvAIAgentKnowledgeBaseSizeModel, 0, len(data.AgentIDs))
+	for _, agentID := range data.AgentIDs {
+		size, err := d.client.GetConvAIAgentKnowledgeBaseSize(agentID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching agent knowledge base size", fmt.Sprintf("agent %s: %s", agentID.ValueString(), err))
+			return
+		}
+		data.Agents = append(data.Agents, ConvAIAgentKnowledgeBaseSizeModel{
+			AgentID:       agentID,
+			NumberOfPages: types.Float64Value(size.NumberOfPages),
+		})
+	}
+
+	overview, err := d.client.GetConvAIRAGIndexOverview()
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching RAG index usage", err.Error())
+		return
+	}
+
+	data.RAGUsedBytes = types.Int64Value(overview.TotalUsedBytes)
+	data.RAGMaxBytes = types.Int64Value(overview.TotalMaxBytes)
+	data.RAGRemainingBytes = types.Int64Value(max(overview.TotalMaxBytes-overview.TotalUsedBytes, 0))
+	data.RAGModels = make([]ConvAIRAGModelUsageModel, 0, len(overview.Models))
+	for _, model := range overview.Models {
+		data.RAGModels = append(data.RAGModels, ConvAIRAGModelUsageModel{
+			Model:     types.StringValue(model.Model),
+			UsedBytes: types.Int64Value(model.UsedBytes),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
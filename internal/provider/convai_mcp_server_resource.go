@@ -29,9 +29,10 @@ type ConvAIMCPServerResource struct {
 }
 
 type ConvAIMCPServerResourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
-	URL  types.String `tfsdk:"url"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	URL       types.String `tfsdk:"url"`
+	Workspace types.String `tfsdk:"workspace"`
 }
 
 func (r *ConvAIMCPServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -54,6 +55,7 @@ func (r *ConvAIMCPServerResource) Schema(ctx context.Context, req resource.Schem
 			"url": schema.StringAttribute{
 				Required: true,
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -82,12 +84,18 @@ func (r *ConvAIMCPServerResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq := &models.CreateConvAIMCPServerRequest{
 		Name: data.Name.ValueString(),
 		URL:  data.URL.ValueString(),
 	}
 
-	server, err := r.client.CreateConvAIMCPServer(addReq)
+	server, err := c.CreateConvAIMCPServer(addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating ConvAI MCP server", err.Error())
 		return
@@ -109,12 +117,18 @@ func (r *ConvAIMCPServerResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := &models.CreateConvAIMCPServerRequest{
 		Name: data.Name.ValueString(),
 		URL:  data.URL.ValueString(),
 	}
 
-	err := r.client.UpdateConvAIMCPServer(data.ID.ValueString(), updateReq)
+	err := c.UpdateConvAIMCPServer(data.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating ConvAI MCP server", err.Error())
 		return
@@ -130,7 +144,13 @@ func (r *ConvAIMCPServerResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := r.client.DeleteConvAIMCPServer(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIMCPServer(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI MCP server", err.Error())
 		return
@@ -138,5 +158,5 @@ func (r *ConvAIMCPServerResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *ConvAIMCPServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
 }
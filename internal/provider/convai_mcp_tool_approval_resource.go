@@ -39,6 +39,7 @@ type ConvAIMCPToolApprovalResourceModel struct {
 	ToolDescription types.String `tfsdk:"tool_description"`
 	InputSchema     types.String `tfsdk:"input_schema"`
 	ApprovalPolicy  types.String `tfsdk:"approval_policy"`
+	Workspace       types.String `tfsdk:"workspace"`
 }
 
 func (r *ConvAIMCPToolApprovalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -76,6 +77,7 @@ func (r *ConvAIMCPToolApprovalResource) Schema(ctx context.Context, req resource
 			"approval_policy": schema.StringAttribute{
 				Optional: true,
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -104,13 +106,19 @@ func (r *ConvAIMCPToolApprovalResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq, err := buildMCPToolApprovalRequest(&data)
 	if err != nil {
 		resp.Diagnostics.AddError("Error parsing MCP tool approval input schema", err.Error())
 		return
 	}
 
-	err = r.client.CreateConvAIMCPToolApproval(data.MCPServerID.ValueString(), addReq)
+	err = c.CreateConvAIMCPToolApproval(data.MCPServerID.ValueString(), addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating ConvAI MCP tool approval", err.Error())
 		return
@@ -132,7 +140,13 @@ func (r *ConvAIMCPToolApprovalResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	err := r.client.DeleteConvAIMCPToolApproval(data.MCPServerID.ValueString(), data.ToolName.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIMCPToolApproval(data.MCPServerID.ValueString(), data.ToolName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI MCP tool approval", err.Error())
 		return
@@ -144,7 +158,7 @@ func (r *ConvAIMCPToolApprovalResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	err = r.client.CreateConvAIMCPToolApproval(data.MCPServerID.ValueString(), addReq)
+	err = c.CreateConvAIMCPToolApproval(data.MCPServerID.ValueString(), addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating ConvAI MCP tool approval", err.Error())
 		return
@@ -162,7 +176,13 @@ func (r *ConvAIMCPToolApprovalResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	err := r.client.DeleteConvAIMCPToolApproval(data.MCPServerID.ValueString(), data.ToolName.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIMCPToolApproval(data.MCPServerID.ValueString(), data.ToolName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI MCP tool approval", err.Error())
 		return
@@ -170,13 +190,14 @@ func (r *ConvAIMCPToolApprovalResource) Delete(ctx context.Context, req resource
 }
 
 func (r *ConvAIMCPToolApprovalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.SplitN(req.ID, ":", 2)
+	id := importStateWorkspace(ctx, req.ID, 1, resp)
+	parts := strings.SplitN(id, ":", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		resp.Diagnostics.AddError("Invalid import ID", "expected ID in format mcp_server_id:tool_name")
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mcp_server_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tool_name"), parts[1])...)
 }
@@ -45,6 +45,7 @@ type ConvAIMCPToolConfigResourceModel struct {
 	ToolCallSoundBehavior types.String                         `tfsdk:"tool_call_sound_behavior"`
 	ExecutionMode         types.String                         `tfsdk:"execution_mode"`
 	Assignments           []ConvAIMCPToolConfigAssignmentModel `tfsdk:"assignments"`
+	Workspace             types.String                         `tfsdk:"workspace"`
 }
 
 func (r *ConvAIMCPToolConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -104,6 +105,7 @@ func (r *ConvAIMCPToolConfigResource) Schema(ctx context.Context, req resource.S
 					},
 				},
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -132,6 +134,12 @@ func (r *ConvAIMCPToolConfigResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq := &models.MCPToolConfigOverrideCreateRequest{
 		ToolName:              data.ToolName.ValueString(),
 		ForcePreToolSpeech:    boolPointerFromValue(data.ForcePreToolSpeech),
@@ -142,13 +150,13 @@ func (r *ConvAIMCPToolConfigResource) Create(ctx context.Context, req resource.C
 		Assignments:           expandMCPAssignments(data.Assignments),
 	}
 
-	err := r.client.CreateConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), addReq)
+	err := c.CreateConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating ConvAI MCP tool config override", err.Error())
 		return
 	}
 
-	config, err := r.client.GetConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), data.ToolName.ValueString())
+	config, err := c.GetConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), data.ToolName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ConvAI MCP tool config override", err.Error())
 		return
@@ -167,7 +175,13 @@ func (r *ConvAIMCPToolConfigResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	config, err := r.client.GetConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), data.ToolName.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := c.GetConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), data.ToolName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ConvAI MCP tool config override", err.Error())
 		return
@@ -186,6 +200,12 @@ func (r *ConvAIMCPToolConfigResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := &models.MCPToolConfigOverrideUpdateRequest{
 		ForcePreToolSpeech:    boolPointerFromValue(data.ForcePreToolSpeech),
 		DisableInterruptions:  boolPointerFromValue(data.DisableInterruptions),
@@ -195,13 +215,13 @@ func (r *ConvAIMCPToolConfigResource) Update(ctx context.Context, req resource.U
 		Assignments:           expandMCPAssignments(data.Assignments),
 	}
 
-	err := r.client.UpdateConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), data.ToolName.ValueString(), updateReq)
+	err := c.UpdateConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), data.ToolName.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating ConvAI MCP tool config override", err.Error())
 		return
 	}
 
-	config, err := r.client.GetConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), data.ToolName.ValueString())
+	config, err := c.GetConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), data.ToolName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ConvAI MCP tool config override", err.Error())
 		return
@@ -220,7 +240,13 @@ func (r *ConvAIMCPToolConfigResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	err := r.client.DeleteConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), data.ToolName.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIMCPToolConfigOverride(data.MCPServerID.ValueString(), data.ToolName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI MCP tool config override", err.Error())
 		return
@@ -228,13 +254,14 @@ func (r *ConvAIMCPToolConfigResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ConvAIMCPToolConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	mcpServerID, toolName, err := parseMCPToolConfigID(req.ID)
+	id := importStateWorkspace(ctx, req.ID, 1, resp)
+	mcpServerID, toolName, err := parseMCPToolConfigID(id)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid import ID", err.Error())
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mcp_server_id"), mcpServerID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tool_name"), toolName)...)
 }
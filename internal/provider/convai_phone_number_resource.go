@@ -33,6 +33,7 @@ type ConvAIPhoneNumberResourceModel struct {
 	PhoneNumber types.String `tfsdk:"phone_number"`
 	Provider    types.String `tfsdk:"telephony_provider"`
 	Label       types.String `tfsdk:"label"`
+	Workspace   types.String `tfsdk:"workspace"`
 }
 
 func (r *ConvAIPhoneNumberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,6 +66,7 @@ func (r *ConvAIPhoneNumberResource) Schema(ctx context.Context, req resource.Sch
 			"label": schema.StringAttribute{
 				Optional: true,
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -93,13 +95,19 @@ func (r *ConvAIPhoneNumberResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq := &models.ImportPhoneNumberRequest{
 		PhoneNumber: data.PhoneNumber.ValueString(),
 		Provider:    data.Provider.ValueString(),
 		Label:       data.Label.ValueString(),
 	}
 
-	phone, err := r.client.ImportConvAIPhoneNumber(addReq)
+	phone, err := c.ImportConvAIPhoneNumber(addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error importing ConvAI phone number", err.Error())
 		return
@@ -121,13 +129,19 @@ func (r *ConvAIPhoneNumberResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := &models.ImportPhoneNumberRequest{
 		PhoneNumber: data.PhoneNumber.ValueString(),
 		Provider:    data.Provider.ValueString(),
 		Label:       data.Label.ValueString(),
 	}
 
-	err := r.client.UpdateConvAIPhoneNumber(data.ID.ValueString(), updateReq)
+	err := c.UpdateConvAIPhoneNumber(data.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating ConvAI phone number", err.Error())
 		return
@@ -143,7 +157,13 @@ func (r *ConvAIPhoneNumberResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	err := r.client.DeleteConvAIPhoneNumber(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIPhoneNumber(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI phone number", err.Error())
 		return
@@ -151,5 +171,5 @@ func (r *ConvAIPhoneNumberResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ConvAIPhoneNumberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
 }
@@ -12,13 +12,13 @@ func TestAccConvAIResourcesAndDataSources(t *testing.T) {
 	server := newTestServer(t, []testRoute{
 		{
 			Method: http.MethodPost,
-			Path:   "/convai/knowledge-base",
-			Body:   `{"documentation_id":"kb-123","name":"KB","type":"text","status":"ready"}`,
+			Path:   "/convai/knowledge-base/text",
+			Body:   `{"id":"kb-123","name":"KB"}`,
 		},
 		{
 			Method: http.MethodGet,
 			Path:   "/convai/knowledge-base/kb-123",
-			Body:   `{"documentation_id":"kb-123","name":"KB","type":"text","status":"ready"}`,
+			Body:   `{"id":"kb-123","name":"KB","type":"text","status":"ready"}`,
 		},
 		{
 			Method: http.MethodDelete,
@@ -27,7 +27,7 @@ func TestAccConvAIResourcesAndDataSources(t *testing.T) {
 		{
 			Method: http.MethodGet,
 			Path:   "/convai/knowledge-base",
-			Body:   `{"documents":[{"documentation_id":"kb-123","name":"KB","type":"text","status":"ready"}],"has_more":false,"next_cursor":""}`,
+			Body:   `{"documents":[{"id":"kb-123","name":"KB","type":"text","status":"ready"}],"has_more":false,"next_cursor":""}`,
 		},
 		{
 			Method: http.MethodPost,
@@ -37,7 +37,7 @@ func TestAccConvAIResourcesAndDataSources(t *testing.T) {
 		{
 			Method: http.MethodGet,
 			Path:   "/convai/knowledge-base/kb-123/rag-index",
-			Body:   `{"indexes":[{"id":"rag-123","model":"e5_mistral_7b_instruct","status":"processing","progress_percentage":50,"document_model_index_usage":{"used_bytes":1234}}]}`,
+			Body:   `{"indexes":[{"id":"rag-123","model":"e5_mistral_7b_instruct","status":"succeeded","progress_percentage":100,"document_model_index_usage":{"used_bytes":1234}}]}`,
 		},
 		{
 			Method: http.MethodDelete,
@@ -46,17 +46,22 @@ func TestAccConvAIResourcesAndDataSources(t *testing.T) {
 		{
 			Method: http.MethodPost,
 			Path:   "/convai/tools",
-			Body:   `{"tool_id":"tool-123","name":"Tool","description":"Desc"}`,
+			Body:   `{"id":"tool-123","tool_config":{"type":"client","name":"Tool","description":"Desc"}}`,
 		},
 		{
 			Method: http.MethodGet,
 			Path:   "/convai/tools/tool-123",
-			Body:   `{"tool_id":"tool-123","name":"Tool","description":"Desc"}`,
+			Body:   `{"id":"tool-123","tool_config":{"type":"client","name":"Tool","description":"Desc"}}`,
 		},
 		{
 			Method: http.MethodPatch,
 			Path:   "/convai/tools/tool-123",
 		},
+		{
+			Method: http.MethodGet,
+			Path:   "/convai/tools/tool-123/dependent-agents",
+			Body:   `{"agents":[],"has_more":false}`,
+		},
 		{
 			Method: http.MethodDelete,
 			Path:   "/convai/tools/tool-123",
@@ -64,7 +69,7 @@ func TestAccConvAIResourcesAndDataSources(t *testing.T) {
 		{
 			Method: http.MethodGet,
 			Path:   "/convai/tools",
-			Body:   `{"tools":[{"tool_id":"tool-123","name":"Tool","description":"Desc"}]}`,
+			Body:   `{"tools":[{"id":"tool-123","tool_config":{"type":"client","name":"Tool","description":"Desc"}}]}`,
 		},
 		{
 			Method: http.MethodPost,
@@ -183,12 +188,12 @@ func TestAccConvAIResourcesAndDataSources(t *testing.T) {
 		{
 			Method: http.MethodPost,
 			Path:   "/convai/agent-testing/create",
-			Body:   `{"test_id":"test-123","name":"Test","success_condition":"ok"}`,
+			Body:   `{"id":"test-123"}`,
 		},
 		{
 			Method: http.MethodGet,
 			Path:   "/convai/agent-testing/test-123",
-			Body:   `{"test_id":"test-123","name":"Test","success_condition":"ok"}`,
+			Body:   `{"id":"test-123","name":"Test","type":"llm","chat_history":[{"role":"user","message":"Hi","time_in_call_secs":0}],"success_condition":"ok","success_examples":[],"failure_examples":[]}`,
 		},
 		{
 			Method: http.MethodDelete,
@@ -322,6 +327,13 @@ resource "elevenlabs_convai_conversation_simulation" "sim" {
 resource "elevenlabs_convai_agent_test" "test" {
   name              = "Test"
   success_condition = "ok"
+
+  chat_history = [
+    {
+      role    = "user"
+      message = "Hi"
+    }
+  ]
 }
 
 resource "elevenlabs_convai_agent_test_runner" "runner" {
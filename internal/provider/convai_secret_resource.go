@@ -4,20 +4,23 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
 )
 
 var (
-	_ resource.Resource                = &ConvAISecretResource{}
-	_ resource.ResourceWithConfigure   = &ConvAISecretResource{}
-	_ resource.ResourceWithImportState = &ConvAISecretResource{}
+	_ resource.Resource                   = &ConvAISecretResource{}
+	_ resource.ResourceWithConfigure      = &ConvAISecretResource{}
+	_ resource.ResourceWithImportState    = &ConvAISecretResource{}
+	_ resource.ResourceWithValidateConfig = &ConvAISecretResource{}
 )
 
 func NewConvAISecretResource() resource.Resource {
@@ -29,9 +32,12 @@ type ConvAISecretResource struct {
 }
 
 type ConvAISecretResourceModel struct {
-	ID    types.String `tfsdk:"id"`
-	Name  types.String `tfsdk:"name"`
-	Value types.String `tfsdk:"value"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Value        types.String `tfsdk:"value"`
+	ValueWO      types.String `tfsdk:"value_wo"`
+	ValueVersion types.Int64  `tfsdk:"value_version"`
+	Workspace    types.String `tfsdk:"workspace"`
 }
 
 func (r *ConvAISecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,9 +58,21 @@ func (r *ConvAISecretResource) Schema(ctx context.Context, req resource.SchemaRe
 				Required: true,
 			},
 			"value": schema.StringAttribute{
-				Required:  true,
-				Sensitive: true,
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret value. Stored in state; prefer `value_wo`. Exactly one of `value` or `value_wo` must be set.",
 			},
+			"value_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Write-only secret value that is sent to ElevenLabs but never stored in state. Requires Terraform 1.11 or later.",
+			},
+			"value_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `value_wo`. Terraform cannot detect changes to write-only values, so increment this to rotate the secret.",
+			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -76,6 +94,10 @@ func (r *ConvAISecretResource) Configure(ctx context.Context, req resource.Confi
 	r.client = client
 }
 
+func (r *ConvAISecretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateWriteOnlyAlternative(ctx, req.Config, "value", &resp.Diagnostics)
+}
+
 func (r *ConvAISecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ConvAISecretResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -83,12 +105,24 @@ func (r *ConvAISecretResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, diags := convAISecretValue(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq := &models.CreateConvAISecretRequest{
 		Name:  data.Name.ValueString(),
-		Value: data.Value.ValueString(),
+		Value: value,
 	}
 
-	secret, err := r.client.CreateConvAISecret(addReq)
+	secret, err := c.CreateConvAISecret(addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating ConvAI secret", err.Error())
 		return
@@ -106,7 +140,13 @@ func (r *ConvAISecretResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	secret, err := r.client.GetConvAISecret(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := c.GetConvAISecret(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ConvAI secret", err.Error())
 		return
@@ -125,12 +165,24 @@ func (r *ConvAISecretResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, diags := convAISecretValue(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := &models.CreateConvAISecretRequest{
 		Name:  data.Name.ValueString(),
-		Value: data.Value.ValueString(),
+		Value: value,
 	}
 
-	err := r.client.UpdateConvAISecret(data.ID.ValueString(), updateReq)
+	err := c.UpdateConvAISecret(data.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating ConvAI secret", err.Error())
 		return
@@ -146,7 +198,13 @@ func (r *ConvAISecretResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := r.client.DeleteConvAISecret(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAISecret(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI secret", err.Error())
 		return
@@ -154,5 +212,18 @@ func (r *ConvAISecretResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *ConvAISecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
+}
+
+// convAISecretValue returns the secret value from either value or the
+// write-only value_wo, which is only available in the configuration.
+// ValidateConfig ensures exactly one of them is set.
+func convAISecretValue(ctx context.Context, config tfsdk.Config, data ConvAISecretResourceModel) (string, diag.Diagnostics) {
+	var valueWO types.String
+	diags := config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)
+	if !valueWO.IsNull() {
+		return valueWO.ValueString(), diags
+	}
+
+	return data.Value.ValueString(), diags
 }
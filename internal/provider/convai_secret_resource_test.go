@@ -1,12 +1,16 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccConvAISecretResource(t *testing.T) {
@@ -96,3 +100,105 @@ resource "elevenlabs_convai_secret" "test" {
 		},
 	})
 }
+
+func TestAccConvAISecretResourceWriteOnly(t *testing.T) {
+	var values []string
+
+	server := newTestServer(t, []testRoute{
+		{
+			Method: http.MethodPost,
+			Path:   "/convai/secrets",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]string
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				values = append(values, body["value"])
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"secret_id":"secret-123","name":"test-secret"}`))
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/convai/secrets/secret-123",
+			Body:   `{"secret_id":"secret-123","name":"test-secret"}`,
+		},
+		{
+			Method: http.MethodPatch,
+			Path:   "/convai/secrets/secret-123",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]string
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				values = append(values, body["value"])
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/convai/secrets/secret-123",
+		},
+	})
+	defer server.Close()
+
+	config := func(value string, version int) string {
+		return fmt.Sprintf(`
+%s
+
+resource "elevenlabs_convai_secret" "test" {
+  name          = "test-secret"
+  value_wo      = "%s"
+  value_version = %d
+}
+`, testAccProviderConfig(server.URL), value, version)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("first-value", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_convai_secret.test", "id", "secret-123"),
+					resource.TestCheckNoResourceAttr("elevenlabs_convai_secret.test", "value_wo"),
+					resource.TestCheckNoResourceAttr("elevenlabs_convai_secret.test", "value"),
+				),
+			},
+			{
+				Config: config("second-value", 2),
+				Check: func(*terraform.State) error {
+					if len(values) != 2 || values[0] != "first-value" || values[1] != "second-value" {
+						return fmt.Errorf("unexpected values sent to the API: %v", values)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestConvAISecretResource_ValidateConfig(t *testing.T) {
+	r := NewConvAISecretResource()
+	name := tftypes.NewValue(tftypes.String, "test-secret")
+	value := tftypes.NewValue(tftypes.String, "secret-value")
+	version := tftypes.NewValue(tftypes.Number, 1)
+
+	tests := map[string]struct {
+		values    map[string]tftypes.Value
+		wantError bool
+	}{
+		"value":                 {map[string]tftypes.Value{"name": name, "value": value}, false},
+		"value_wo with version": {map[string]tftypes.Value{"name": name, "value_wo": value, "value_version": version}, false},
+		"both":                  {map[string]tftypes.Value{"name": name, "value": value, "value_wo": value}, true},
+		"neither":               {map[string]tftypes.Value{"name": name}, true},
+		"version without wo":    {map[string]tftypes.Value{"name": name, "value": value, "value_version": version}, true},
+		"unknown value":         {map[string]tftypes.Value{"name": name, "value": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)}, false},
+	}
+
+	for name, tt := range tests {
+		diags := validateResourceConfig(t, r, tt.values)
+		if diags.HasError() != tt.wantError {
+			t.Errorf("%s: expected error %t, got %v", name, tt.wantError, diags)
+		}
+	}
+}
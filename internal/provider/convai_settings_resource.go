@@ -28,6 +28,7 @@ type ConvAISettingsResourceModel struct {
 	// For simplicity, we'll handle this as a JSON string or dynamic map if supported
 	// but for now, let's just make it a placeholder or specific fields if known.
 	// Task 98 research mentioned it exists.
+	Workspace types.String `tfsdk:"workspace"`
 }
 
 func (r *ConvAISettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,6 +42,7 @@ func (r *ConvAISettingsResource) Schema(ctx context.Context, req resource.Schema
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -64,13 +66,29 @@ func (r *ConvAISettingsResource) Configure(ctx context.Context, req resource.Con
 
 func (r *ConvAISettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ConvAISettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.ID = types.StringValue("workspace_settings")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ConvAISettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data ConvAISettingsResourceModel
-	_, err := r.client.GetConvAISettings()
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := c.GetConvAISettings()
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ConvAI settings", err.Error())
 		return
@@ -37,6 +37,7 @@ func (d *ConvAISignedUrlDataSource) Metadata(ctx context.Context, req datasource
 func (d *ConvAISignedUrlDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Data source for generating signed URLs for ElevenLabs ConvAI agent conversations.",
+		DeprecationMessage:  "The conversation signature is short-lived and stored in state. Use the `elevenlabs_convai_signed_url` ephemeral resource instead.",
 		Attributes: map[string]schema.Attribute{
 			"agent_id": schema.StringAttribute{
 				Required:            true,
@@ -87,7 +88,7 @@ func (d *ConvAISignedUrlDataSource) Read(ctx context.Context, req datasource.Rea
 		includeConversationID = data.IncludeConversationID.ValueBool()
 	}
 
-	signature, conversationID, err := d.client.GetConvAISignedUrl(
+	signedURL, err := d.client.GetConvAISignedUrl(
 		data.AgentID.ValueString(),
 		includeConversationID,
 	)
@@ -97,9 +98,9 @@ func (d *ConvAISignedUrlDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	data.ConversationSignature = types.StringValue(signature)
-	if conversationID != "" {
-		data.ConversationID = types.StringValue(conversationID)
+	data.ConversationSignature = types.StringValue(signedURL.ConversationSignature)
+	if signedURL.ConversationID != "" {
+		data.ConversationID = types.StringValue(signedURL.ConversationID)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
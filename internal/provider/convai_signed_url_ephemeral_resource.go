@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &ConvAISignedUrlEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ConvAISignedUrlEphemeralResource{}
+)
+
+func NewConvAISignedUrlEphemeralResource() ephemeral.EphemeralResource {
+	return &ConvAISignedUrlEphemeralResource{}
+}
+
+type ConvAISignedUrlEphemeralResource struct {
+	client *client.Client
+}
+
+type ConvAISignedUrlEphemeralResourceModel struct {
+	AgentID               types.String `tfsdk:"agent_id"`
+	IncludeConversationID types.Bool   `tfsdk:"include_conversation_id"`
+	SignedURL             types.String `tfsdk:"signed_url"`
+	ConversationSignature types.String `tfsdk:"conversation_signature"`
+	ConversationID        types.String `tfsdk:"conversation_id"`
+	Workspace             types.String `tfsdk:"workspace"`
+}
+
+func (r *ConvAISignedUrlEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_convai_signed_url"
+}
+
+func (r *ConvAISignedUrlEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a signed URL for an ElevenLabs ConvAI agent conversation without storing it in state.",
+		Attributes: map[string]schema.Attribute{
+			"agent_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the agent to generate a signed URL for.",
+			},
+			"include_conversation_id": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to include a conversation ID with the response. If included, the signed URL cannot be used again.",
+			},
+			"signed_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The signed WebSocket URL for the conversation.",
+			},
+			"conversation_signature": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The signed conversation signature for authentication.",
+			},
+			"conversation_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The conversation ID (only included if include_conversation_id is true).",
+			},
+			"workspace": ephemeralWorkspaceAttribute(),
+		},
+	}
+}
+
+func (r *ConvAISignedUrlEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ConvAISignedUrlEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ConvAISignedUrlEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	signedURL, err := c.GetConvAISignedUrl(data.AgentID.ValueString(), data.IncludeConversationID.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating signed URL", err.Error())
+		return
+	}
+
+	data.SignedURL = optionalStringValue(signedURL.SignedURL)
+	data.ConversationSignature = optionalStringValue(signedURL.ConversationSignature)
+	data.ConversationID = optionalStringValue(signedURL.ConversationID)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
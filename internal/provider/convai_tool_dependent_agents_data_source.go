@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+var (
+	_ datasource.DataSource              = &ConvAIToolDependentAgentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConvAIToolDependentAgentsDataSource{}
+)
+
+func NewConvAIToolDependentAgentsDataSource() datasource.DataSource {
+	return &ConvAIToolDependentAgentsDataSource{}
+}
+
+type ConvAIToolDependentAgentsDataSource struct {
+	client *client.Client
+}
+
+type ConvAIToolDependentAgentsDataSourceModel struct {
+	ToolID                 types.String                `tfsdk:"tool_id"`
+	AgentIDs               []types.String              `tfsdk:"agent_ids"`
+	InaccessibleAgentCount types.Int64                 `tfsdk:"inaccessible_agent_count"`
+	Agents                 []ConvAIDependentAgentModel `tfsdk:"agents"`
+}
+
+type ConvAIDependentAgentModel struct {
+	AgentID               types.String   `tfsdk:"agent_id"`
+	Name                  types.String   `tfsdk:"name"`
+	Type                  types.String   `tfsdk:"type"`
+	AccessLevel           types.String   `tfsdk:"access_level"`
+	CreatedAtUnixSecs     types.Int64    `tfsdk:"created_at_unix_secs"`
+	ReferencedResourceIDs []types.String `tfsdk:"referenced_resource_ids"`
+}
+
+func (d *ConvAIToolDependentAgentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_convai_tool_dependent_agents"
+}
+
+func (d *ConvAIToolDependentAgentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the agents that use a ConvAI tool.",
+		Attributes: map[string]schema.Attribute{
+			"tool_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the tool.",
+			},
+			"agent_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the dependent agents you can access.",
+			},
+			"inaccessible_agent_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of dependent agents you do not have access to.",
+			},
+			"agents": dependentAgentsAttribute("Every agent that uses the tool."),
+		},
+	}
+}
+
+func (d *ConvAIToolDependentAgentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConvAIToolDependentAgentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConvAIToolDependentAgentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agents, err := d.client.GetConvAIToolDependentAgents(data.ToolID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching agents using ConvAI tool", err.Error())
+		return
+	}
+
+	data.AgentIDs = []types.String{}
+	data.Agents = make([]ConvAIDependentAgentModel, 0, len(agents))
+	inaccessible := int64(0)
+	for _, agent := range agents {
+		if agent.ID == "" {
+			inaccessible++
+		} else {
+			data.AgentIDs = append(data.AgentIDs, types.StringValue(agent.ID))
+		}
+		data.Agents = append(data.Agents, flattenConvAIDependentAgent(agent))
+	}
+	data.InaccessibleAgentCount = types.Int64Value(inaccessible)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenConvAIDependentAgent(agent models.ConvAIDependentAgent) ConvAIDependentAgentModel {
+	model := ConvAIDependentAgentModel{
+		AgentID:               optionalStringValue(agent.ID),
+		Name:                  optionalStringValue(agent.Name),
+		Type:                  types.StringValue(agent.Type),
+		AccessLevel:           optionalStringValue(agent.AccessLevel),
+		CreatedAtUnixSecs:     types.Int64Null(),
+		ReferencedResourceIDs: []types.String{},
+	}
+	if agent.CreatedAtUnixSecs != 0 {
+		model.CreatedAtUnixSecs = types.Int64Value(agent.CreatedAtUnixSecs)
+	}
+	for _, id := range agent.ReferencedResourceIDs {
+		model.ReferencedResourceIDs = append(model.ReferencedResourceIDs, types.StringValue(id))
+	}
+	return model
+}
+
+func dependentAgentsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: description,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"agent_id": schema.StringAttribute{
+					Computed: true,
+				},
+				"name": schema.StringAttribute{
+					Computed: true,
+				},
+				"type": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "`available`, or `unknown` for agents you cannot access.",
+				},
+				"access_level": schema.StringAttribute{
+					Computed: true,
+				},
+				"created_at_unix_secs": schema.Int64Attribute{
+					Computed: true,
+				},
+				"referenced_resource_ids": schema.ListAttribute{
+					Computed:            true,
+					ElementType:         types.StringType,
+					MarkdownDescription: "For agents that depend indirectly, the resources they use directly.",
+				},
+			},
+		},
+	}
+}
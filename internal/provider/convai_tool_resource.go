@@ -2,12 +2,20 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
@@ -18,6 +26,7 @@ var (
 	_ resource.Resource                = &ConvAIToolResource{}
 	_ resource.ResourceWithConfigure   = &ConvAIToolResource{}
 	_ resource.ResourceWithImportState = &ConvAIToolResource{}
+	_ resource.ResourceWithModifyPlan  = &ConvAIToolResource{}
 )
 
 func NewConvAIToolResource() resource.Resource {
@@ -29,9 +38,53 @@ type ConvAIToolResource struct {
 }
 
 type ConvAIToolResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	ID                    types.String                         `tfsdk:"id"`
+	Name                  types.String                         `tfsdk:"name"`
+	Description           types.String                         `tfsdk:"description"`
+	Type                  types.String                         `tfsdk:"type"`
+	ResponseTimeoutSecs   types.Int64                          `tfsdk:"response_timeout_secs"`
+	DisableInterruptions  types.Bool                           `tfsdk:"disable_interruptions"`
+	ForcePreToolSpeech    types.Bool                           `tfsdk:"force_pre_tool_speech"`
+	ToolCallSound         types.String                         `tfsdk:"tool_call_sound"`
+	ToolCallSoundBehavior types.String                         `tfsdk:"tool_call_sound_behavior"`
+	ExecutionMode         types.String                         `tfsdk:"execution_mode"`
+	Assignments           []ConvAIMCPToolConfigAssignmentModel `tfsdk:"assignments"`
+	Webhook               *ConvAIWebhookToolModel              `tfsdk:"webhook"`
+	Client                *ConvAIClientToolModel               `tfsdk:"client"`
+	System                *ConvAISystemToolModel               `tfsdk:"system"`
+	ForceDetach           types.Bool                           `tfsdk:"force_detach"`
+	Workspace             types.String                         `tfsdk:"workspace"`
+}
+
+type ConvAIWebhookToolModel struct {
+	URL                    types.String                    `tfsdk:"url"`
+	Method                 types.String                    `tfsdk:"method"`
+	ContentType            types.String                    `tfsdk:"content_type"`
+	RequestHeaders         types.Map                       `tfsdk:"request_headers"`
+	SecretHeaders          types.Map                       `tfsdk:"secret_headers"`
+	DynamicVariableHeaders types.Map                       `tfsdk:"dynamic_variable_headers"`
+	PathParams             map[string]ConvAIToolParamModel `tfsdk:"path_params"`
+	QueryParams            map[string]ConvAIToolParamModel `tfsdk:"query_params"`
+	RequestBodySchema      types.String                    `tfsdk:"request_body_schema"`
+}
+
+type ConvAIToolParamModel struct {
+	Type            types.String `tfsdk:"type"`
+	Description     types.String `tfsdk:"description"`
+	Enum            types.List   `tfsdk:"enum"`
+	DynamicVariable types.String `tfsdk:"dynamic_variable"`
+	ConstantValue   types.String `tfsdk:"constant_value"`
+	Required        types.Bool   `tfsdk:"required"`
+}
+
+type ConvAIClientToolModel struct {
+	Parameters      types.String `tfsdk:"parameters"`
+	ExpectsResponse types.Bool   `tfsdk:"expects_response"`
+}
+
+type ConvAISystemToolModel struct {
+	SystemToolType types.String `tfsdk:"system_tool_type"`
+	Params         types.String `tfsdk:"params"`
 }
 
 func (r *ConvAIToolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,8 +92,38 @@ func (r *ConvAIToolResource) Metadata(ctx context.Context, req resource.Metadata
 }
 
 func (r *ConvAIToolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	paramAttributes := map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "One of `string`, `integer`, `number` or `boolean`.",
+		},
+		"description": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Tells the LLM what value to fill in.",
+		},
+		"enum": schema.ListAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Allowed values for string parameters.",
+		},
+		"dynamic_variable": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Fill the parameter from this dynamic variable instead of the LLM.",
+		},
+		"constant_value": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Always send this value instead of asking the LLM.",
+		},
+		"required": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			MarkdownDescription: "Whether the query parameter is required. Path parameters are always required.",
+		},
+	}
+
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Conversational AI Tool resource for ElevenLabs.",
+		MarkdownDescription: "Conversational AI Tool resource for ElevenLabs. Set one of `webhook`, `client` or `system`; without any of them a client tool is created.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -49,11 +132,172 @@ func (r *ConvAIToolResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"name": schema.StringAttribute{
-				Required: true,
+				Required:            true,
+				MarkdownDescription: "Name of the tool as seen by the LLM. Letters, digits, `_` and `-` only.",
 			},
 			"description": schema.StringAttribute{
-				Required: true,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "When the tool should be used and what it does. System tools use a built-in description when empty.",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The tool type: `webhook`, `client` or `system`.",
+			},
+			"response_timeout_secs": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Maximum time to wait for the tool call to complete.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"disable_interruptions": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Prevent the user from interrupting the agent while the tool runs.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_pre_tool_speech": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Make the agent speak before calling the tool.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tool_call_sound": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Sound to play while the tool runs.",
+			},
+			"tool_call_sound_behavior": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "When to play the tool call sound: `auto` or `always`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"execution_mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "When the tool executes, e.g. `immediate`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"assignments": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Values to extract from the tool response into dynamic variables.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Optional: true,
+						},
+						"dynamic_variable": schema.StringAttribute{
+							Required: true,
+						},
+						"value_path": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"webhook": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of a tool that calls an HTTP endpoint.",
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "URL to call. May contain `{placeholders}` for `path_params`.",
+					},
+					"method": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("GET"),
+						MarkdownDescription: "HTTP method. Defaults to `GET`.",
+					},
+					"content_type": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("application/json"),
+						MarkdownDescription: "`application/json` or `application/x-www-form-urlencoded`.",
+					},
+					"request_headers": schema.MapAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Headers with literal values.",
+					},
+					"secret_headers": schema.MapAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Headers whose value is read from a workspace secret, keyed by header name with the secret ID as value.",
+					},
+					"dynamic_variable_headers": schema.MapAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Headers whose value is read from a dynamic variable, keyed by header name with the variable name as value.",
+					},
+					"path_params": schema.MapNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Parameters substituted into the URL, keyed by placeholder name.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: paramAttributes,
+						},
+					},
+					"query_params": schema.MapNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Parameters added to the query string, keyed by name.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: paramAttributes,
+						},
+					},
+					"request_body_schema": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "JSON schema of the request body, as a JSON-encoded object schema.",
+					},
+				},
+			},
+			"client": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of a tool that is executed by the client application.",
+				Attributes: map[string]schema.Attribute{
+					"parameters": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "JSON schema of the parameters passed to the client, as a JSON-encoded object schema.",
+					},
+					"expects_response": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Block the conversation until the client responds.",
+					},
+				},
+			},
+			"system": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of a built-in system tool.",
+				Attributes: map[string]schema.Attribute{
+					"system_tool_type": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The system tool, e.g. `end_call`, `language_detection`, `transfer_to_agent` or `transfer_to_number`.",
+					},
+					"params": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Additional JSON-encoded parameters of the system tool, such as `transfers`.",
+					},
+				},
+			},
+			"force_detach": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Remove the tool from the agents that use it before deleting it. When `false`, deleting a tool that is still in use fails.",
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -75,6 +319,49 @@ func (r *ConvAIToolResource) Configure(ctx context.Context, req resource.Configu
 	r.client = client
 }
 
+// ModifyPlan derives the tool type from the configured block. The API cannot
+// change the type of a tool, so a different block replaces the tool.
+func (r *ConvAIToolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	blocks := map[string]types.Object{}
+	for _, name := range []string{"webhook", "client", "system"} {
+		var block types.Object
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &block)...)
+		if !block.IsNull() {
+			blocks[name] = block
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toolType := "client"
+	switch len(blocks) {
+	case 0:
+	case 1:
+		for name := range blocks {
+			toolType = name
+		}
+	default:
+		resp.Diagnostics.AddError("Invalid Configuration", "Only one of webhook, client or system may be set.")
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"), toolType)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var stateType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateType)...)
+	if !stateType.IsNull() && stateType.ValueString() != toolType {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("type"))
+	}
+}
+
 func (r *ConvAIToolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ConvAIToolResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -82,18 +369,29 @@ func (r *ConvAIToolResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	addReq := &models.CreateConvAIToolRequest{
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	tool, err := r.client.CreateConvAITool(addReq)
+	toolConfig, diags := expandConvAIToolConfig(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tool, err := c.CreateConvAITool(&models.CreateConvAIToolRequest{ToolConfig: toolConfig})
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating ConvAI tool", err.Error())
 		return
 	}
 
 	data.ID = types.StringValue(tool.ToolID)
+	resp.Diagnostics.Append(applyConvAIToolToState(ctx, &data, tool)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -105,14 +403,25 @@ func (r *ConvAIToolResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	tool, err := r.client.GetConvAITool(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tool, err := c.GetConvAITool(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ConvAI tool", err.Error())
 		return
 	}
 
-	data.Name = types.StringValue(tool.Name)
-	data.Description = types.StringValue(tool.Description)
+	resp.Diagnostics.Append(applyConvAIToolToState(ctx, &data, tool)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.ForceDetach.IsNull() {
+		data.ForceDetach = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -124,17 +433,35 @@ func (r *ConvAIToolResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	updateReq := &models.CreateConvAIToolRequest{
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toolConfig, diags := expandConvAIToolConfig(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err := r.client.UpdateConvAITool(data.ID.ValueString(), updateReq)
+	err := c.UpdateConvAITool(data.ID.ValueString(), &models.CreateConvAIToolRequest{ToolConfig: toolConfig})
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating ConvAI tool", err.Error())
 		return
 	}
 
+	tool, err := c.GetConvAITool(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ConvAI tool", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(applyConvAIToolToState(ctx, &data, tool)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -145,7 +472,34 @@ func (r *ConvAIToolResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err := r.client.DeleteConvAITool(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dependents, err := c.GetConvAIToolDependentAgents(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching agents using ConvAI tool", err.Error())
+		return
+	}
+	if len(dependents) > 0 {
+		if !data.ForceDetach.ValueBool() {
+			resp.Diagnostics.AddError(
+				"ConvAI tool is still in use",
+				fmt.Sprintf("Tool %s is used by %s. Remove it from these agents, or set force_detach = true and apply before destroying.",
+					data.ID.ValueString(), describeDependentAgents(dependents)),
+			)
+			return
+		}
+
+		if err := detachConvAIToolFromAgents(c, data.ID.ValueString(), dependents); err != nil {
+			resp.Diagnostics.AddError("Error detaching ConvAI tool from agents", err.Error())
+			return
+		}
+	}
+
+	err = c.DeleteConvAITool(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI tool", err.Error())
 		return
@@ -153,5 +507,371 @@ func (r *ConvAIToolResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *ConvAIToolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
+}
+
+func expandConvAIToolConfig(ctx context.Context, data *ConvAIToolResourceModel) (models.ConvAIToolConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	toolConfig := models.ConvAIToolConfig{
+		Type:                  data.Type.ValueString(),
+		Name:                  data.Name.ValueString(),
+		Description:           data.Description.ValueString(),
+		DisableInterruptions:  boolPointerFromValue(data.DisableInterruptions),
+		ForcePreToolSpeech:    boolPointerFromValue(data.ForcePreToolSpeech),
+		ToolCallSound:         stringPointerFromValue(data.ToolCallSound),
+		ToolCallSoundBehavior: stringPointerFromValue(data.ToolCallSoundBehavior),
+		ExecutionMode:         stringPointerFromValue(data.ExecutionMode),
+		Assignments:           expandMCPAssignments(data.Assignments),
+	}
+	if !data.ResponseTimeoutSecs.IsNull() && !data.ResponseTimeoutSecs.IsUnknown() {
+		timeout := data.ResponseTimeoutSecs.ValueInt64()
+		toolConfig.ResponseTimeoutSecs = &timeout
+	}
+
+	switch {
+	case data.Webhook != nil:
+		webhook := data.Webhook
+		apiSchema := &models.ConvAIWebhookToolAPISchema{
+			URL:            webhook.URL.ValueString(),
+			Method:         webhook.Method.ValueString(),
+			ContentType:    webhook.ContentType.ValueString(),
+			RequestHeaders: map[string]interface{}{},
+		}
+
+		headers := map[string]string{}
+		diags.Append(webhook.RequestHeaders.ElementsAs(ctx, &headers, false)...)
+		for name, value := range headers {
+			apiSchema.RequestHeaders[name] = value
+		}
+		secretHeaders := map[string]string{}
+		diags.Append(webhook.SecretHeaders.ElementsAs(ctx, &secretHeaders, false)...)
+		for name, secretID := range secretHeaders {
+			apiSchema.RequestHeaders[name] = models.ConvAISecretLocator{SecretID: secretID}
+		}
+		variableHeaders := map[string]string{}
+		diags.Append(webhook.DynamicVariableHeaders.ElementsAs(ctx, &variableHeaders, false)...)
+		for name, variable := range variableHeaders {
+			apiSchema.RequestHeaders[name] = models.ConvAIDynamicVariable{VariableName: variable}
+		}
+		if diags.HasError() {
+			return toolConfig, diags
+		}
+
+		if len(webhook.PathParams) > 0 {
+			apiSchema.PathParamsSchema = map[string]models.ConvAILiteralJSONSchemaProperty{}
+			for name, param := range webhook.PathParams {
+				property, d := expandConvAIToolParam(ctx, param)
+				diags.Append(d...)
+				apiSchema.PathParamsSchema[name] = property
+			}
+		}
+		if len(webhook.QueryParams) > 0 {
+			apiSchema.QueryParamsSchema = &models.ConvAIQueryParamsJSONSchema{
+				Properties: map[string]models.ConvAILiteralJSONSchemaProperty{},
+			}
+			for name, param := range webhook.QueryParams {
+				property, d := expandConvAIToolParam(ctx, param)
+				diags.Append(d...)
+				apiSchema.QueryParamsSchema.Properties[name] = property
+				if param.Required.ValueBool() {
+					apiSchema.QueryParamsSchema.Required = append(apiSchema.QueryParamsSchema.Required, name)
+				}
+			}
+			sort.Strings(apiSchema.QueryParamsSchema.Required)
+		}
+
+		body, err := jsonObjectFromString(webhook.RequestBodySchema)
+		if err != nil {
+			diags.AddAttributeError(path.Root("webhook").AtName("request_body_schema"), "Invalid Configuration", err.Error())
+		}
+		apiSchema.RequestBodySchema = body
+		toolConfig.APISchema = apiSchema
+
+	case data.System != nil:
+		params, err := jsonObjectFromString(data.System.Params)
+		if err != nil {
+			diags.AddAttributeError(path.Root("system").AtName("params"), "Invalid Configuration", err.Error())
+		}
+		if params == nil {
+			params = map[string]interface{}{}
+		}
+		params["system_tool_type"] = data.System.SystemToolType.ValueString()
+		toolConfig.Params = params
+
+	default:
+		toolConfig.Type = "client"
+		if data.Client != nil {
+			parameters, err := jsonObjectFromString(data.Client.Parameters)
+			if err != nil {
+				diags.AddAttributeError(path.Root("client").AtName("parameters"), "Invalid Configuration", err.Error())
+			}
+			toolConfig.Parameters = parameters
+			toolConfig.ExpectsResponse = boolPointerFromValue(data.Client.ExpectsResponse)
+		}
+	}
+
+	return toolConfig, diags
+}
+
+func expandConvAIToolParam(ctx context.Context, param ConvAIToolParamModel) (models.ConvAILiteralJSONSchemaProperty, diag.Diagnostics) {
+	property := models.ConvAILiteralJSONSchemaProperty{
+		Type:            param.Type.ValueString(),
+		Description:     param.Description.ValueString(),
+		DynamicVariable: param.DynamicVariable.ValueString(),
+	}
+	if !param.ConstantValue.IsNull() && !param.ConstantValue.IsUnknown() {
+		property.ConstantValue = param.ConstantValue.ValueString()
+	}
+
+	enum, diags := stringSliceFromList(ctx, param.Enum)
+	property.Enum = enum
+	return property, diags
+}
+
+// applyConvAIToolToState copies the tool configuration into the model. The
+// JSON-encoded schemas are only read back when they are not set yet, because
+// the API adds defaults that would otherwise show up as drift.
+func applyConvAIToolToState(ctx context.Context, data *ConvAIToolResourceModel, tool *models.ConvAITool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	config := tool.ToolConfig
+
+	data.Name = types.StringValue(config.Name)
+	data.Description = types.StringValue(config.Description)
+	data.Type = types.StringValue(config.Type)
+	data.ResponseTimeoutSecs = types.Int64Null()
+	if config.ResponseTimeoutSecs != nil {
+		data.ResponseTimeoutSecs = types.Int64Value(*config.ResponseTimeoutSecs)
+	}
+	data.DisableInterruptions = boolValueOrNull(config.DisableInterruptions)
+	data.ForcePreToolSpeech = boolValueOrNull(config.ForcePreToolSpeech)
+	data.ToolCallSound = stringValueOrNull(config.ToolCallSound)
+	data.ToolCallSoundBehavior = stringValueOrNull(config.ToolCallSoundBehavior)
+	data.ExecutionMode = stringValueOrNull(config.ExecutionMode)
+	data.Assignments = flattenMCPAssignments(config.Assignments)
+
+	switch config.Type {
+	case "webhook":
+		previous := data.Webhook
+		webhook := &ConvAIWebhookToolModel{
+			URL:               types.StringNull(),
+			Method:            types.StringValue("GET"),
+			ContentType:       types.StringValue("application/json"),
+			RequestBodySchema: types.StringNull(),
+		}
+		if previous != nil {
+			webhook.RequestBodySchema = previous.RequestBodySchema
+		}
+
+		headers := map[string]string{}
+		secretHeaders := map[string]string{}
+		variableHeaders := map[string]string{}
+		if apiSchema := config.APISchema; apiSchema != nil {
+			webhook.URL = types.StringValue(apiSchema.URL)
+			if apiSchema.Method != "" {
+				webhook.Method = types.StringValue(apiSchema.Method)
+			}
+			if apiSchema.ContentType != "" {
+				webhook.ContentType = types.StringValue(apiSchema.ContentType)
+			}
+
+			for name, value := range apiSchema.RequestHeaders {
+				switch v := value.(type) {
+				case string:
+					headers[name] = v
+				case map[string]interface{}:
+					if secretID, ok := v["secret_id"].(string); ok {
+						secretHeaders[name] = secretID
+					} else if variable, ok := v["variable_name"].(string); ok {
+						variableHeaders[name] = variable
+					}
+				}
+			}
+
+			if len(apiSchema.PathParamsSchema) > 0 {
+				webhook.PathParams = map[string]ConvAIToolParamModel{}
+				for name, property := range apiSchema.PathParamsSchema {
+					param, d := flattenConvAIToolParam(ctx, property, false)
+					diags.Append(d...)
+					webhook.PathParams[name] = param
+				}
+			}
+			if query := apiSchema.QueryParamsSchema; query != nil && len(query.Properties) > 0 {
+				required := map[string]bool{}
+				for _, name := range query.Required {
+					required[name] = true
+				}
+				webhook.QueryParams = map[string]ConvAIToolParamModel{}
+				for name, property := range query.Properties {
+					param, d := flattenConvAIToolParam(ctx, property, required[name])
+					diags.Append(d...)
+					webhook.QueryParams[name] = param
+				}
+			}
+
+			if webhook.RequestBodySchema.IsNull() && len(apiSchema.RequestBodySchema) > 0 {
+				webhook.RequestBodySchema = jsonStringValue(apiSchema.RequestBodySchema)
+			}
+		}
+
+		webhook.RequestHeaders = stringMapValueOrNull(ctx, headers, &diags)
+		webhook.SecretHeaders = stringMapValueOrNull(ctx, secretHeaders, &diags)
+		webhook.DynamicVariableHeaders = stringMapValueOrNull(ctx, variableHeaders, &diags)
+
+		data.Webhook = webhook
+		data.Client = nil
+		data.System = nil
+
+	case "system":
+		system := &ConvAISystemToolModel{
+			SystemToolType: types.StringNull(),
+			Params:         types.StringNull(),
+		}
+		if data.System != nil {
+			system.Params = data.System.Params
+		}
+
+		params := map[string]interface{}{}
+		for key, value := range config.Params {
+			if key == "system_tool_type" {
+				if toolType, ok := value.(string); ok {
+					system.SystemToolType = types.StringValue(toolType)
+				}
+				continue
+			}
+			params[key] = value
+		}
+		if system.Params.IsNull() && len(params) > 0 {
+			system.Params = jsonStringValue(params)
+		}
+
+		data.System = system
+		data.Webhook = nil
+		data.Client = nil
+
+	default:
+		// A client tool without parameters is configured without a block.
+		if data.Client != nil || len(config.Parameters) > 0 || (config.ExpectsResponse != nil && *config.ExpectsResponse) {
+			clientTool := &ConvAIClientToolModel{
+				Parameters:      types.StringNull(),
+				ExpectsResponse: types.BoolValue(config.ExpectsResponse != nil && *config.ExpectsResponse),
+			}
+			if data.Client != nil {
+				clientTool.Parameters = data.Client.Parameters
+			}
+			if clientTool.Parameters.IsNull() && len(config.Parameters) > 0 {
+				clientTool.Parameters = jsonStringValue(config.Parameters)
+			}
+			data.Client = clientTool
+		}
+		data.Webhook = nil
+		data.System = nil
+	}
+
+	return diags
+}
+
+func flattenConvAIToolParam(ctx context.Context, property models.ConvAILiteralJSONSchemaProperty, required bool) (ConvAIToolParamModel, diag.Diagnostics) {
+	param := ConvAIToolParamModel{
+		Type:            types.StringValue(property.Type),
+		Description:     optionalStringValue(property.Description),
+		DynamicVariable: optionalStringValue(property.DynamicVariable),
+		ConstantValue:   types.StringNull(),
+		Required:        types.BoolValue(required),
+	}
+	if property.ConstantValue != nil && property.ConstantValue != "" {
+		param.ConstantValue = types.StringValue(fmt.Sprint(property.ConstantValue))
+	}
+
+	enum, diags := stringsToListValue(ctx, property.Enum)
+	param.Enum = enum
+	return param, diags
+}
+
+func stringMapValueOrNull(ctx context.Context, values map[string]string, diags *diag.Diagnostics) types.Map {
+	if len(values) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	value, d := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return value
+}
+
+// jsonObjectFromString decodes a JSON-encoded object attribute, returning nil
+// when it is not set.
+func jsonObjectFromString(value types.String) (map[string]interface{}, error) {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return nil, nil
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &object); err != nil {
+		return nil, fmt.Errorf("expected a JSON object: %w", err)
+	}
+	return object, nil
+}
+
+// describeDependentAgents lists the agents by name and ID for error messages.
+func describeDependentAgents(agents []models.ConvAIDependentAgent) string {
+	var names []string
+	inaccessible := 0
+	for _, agent := range agents {
+		if agent.ID == "" {
+			inaccessible++
+			continue
+		}
+		names = append(names, fmt.Sprintf("%q (%s)", agent.Name, agent.ID))
+	}
+	if inaccessible > 0 {
+		names = append(names, fmt.Sprintf("%d agent(s) you do not have access to", inaccessible))
+	}
+	return strings.Join(names, ", ")
+}
+
+// detachConvAIToolFromAgents removes the tool from the tool_ids of every
+// dependent agent. Agents the caller cannot access cannot be changed, so they
+// fail the detach before any agent is modified.
+func detachConvAIToolFromAgents(c *client.Client, toolID string, agents []models.ConvAIDependentAgent) error {
+	for _, agent := range agents {
+		if agent.ID == "" {
+			return fmt.Errorf("tool %s is used by agents you do not have access to", toolID)
+		}
+	}
+
+	for _, agent := range agents {
+		conversationConfig, err := c.GetConvAIAgentConversationConfig(agent.ID)
+		if err != nil {
+			return fmt.Errorf("reading agent %s: %w", agent.ID, err)
+		}
+		if !removeToolFromConversationConfig(conversationConfig, toolID) {
+			// The agent uses the tool through another resource.
+			continue
+		}
+		if err := c.UpdateConvAIAgentConversationConfig(agent.ID, conversationConfig); err != nil {
+			return fmt.Errorf("updating agent %s: %w", agent.ID, err)
+		}
+	}
+	return nil
+}
+
+// removeToolFromConversationConfig drops toolID from agent.prompt.tool_ids and
+// reports whether it was present.
+func removeToolFromConversationConfig(conversationConfig map[string]interface{}, toolID string) bool {
+	agent, _ := conversationConfig["agent"].(map[string]interface{})
+	prompt, _ := agent["prompt"].(map[string]interface{})
+	toolIDs, _ := prompt["tool_ids"].([]interface{})
+
+	kept := make([]interface{}, 0, len(toolIDs))
+	for _, id := range toolIDs {
+		if id != toolID {
+			kept = append(kept, id)
+		}
+	}
+	if len(kept) == len(toolIDs) {
+		return false
+	}
+
+	prompt["tool_ids"] = kept
+	return true
 }
@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+func TestAccConvAIToolResourceWebhook(t *testing.T) {
+	var mu sync.Mutex
+	stored := []byte(`{}`)
+
+	store := func(w http.ResponseWriter, r *http.Request) {
+		var body models.CreateConvAIToolRequest
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		stored, _ = json.Marshal(models.ConvAITool{ToolID: "tool-webhook", ToolConfig: body.ToolConfig})
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(stored)
+	}
+
+	server := newTestServer(t, []testRoute{
+		{Method: httpMethodPost, Path: "/convai/tools", Handler: store},
+		{Method: httpMethodPatch, Path: "/convai/tools/tool-webhook", Handler: store},
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/tools/tool-webhook",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(stored)
+			},
+		},
+		{Method: httpMethodGet, Path: "/convai/tools/tool-webhook/dependent-agents", Body: `{"agents":[],"has_more":false}`},
+		{Method: httpMethodDelete, Path: "/convai/tools/tool-webhook"},
+	})
+	defer server.Close()
+
+	config := func(method string) string {
+		return fmt.Sprintf(`
+%s
+
+resource "elevenlabs_convai_tool" "weather" {
+  name                  = "get_weather"
+  description           = "Looks up the weather"
+  response_timeout_secs = 20
+
+  webhook = {
+    url    = "https://api.example.com/weather/{city}"
+    method = %q
+
+    request_headers          = { "X-Client" = "agent" }
+    secret_headers           = { "Authorization" = "secret-123" }
+    dynamic_variable_headers = { "X-User" = "user_id" }
+
+    path_params = {
+      city = { type = "string", description = "City name" }
+    }
+    query_params = {
+      units = { type = "string", enum = ["metric", "imperial"], required = true }
+    }
+  }
+}
+`, testAccProviderConfig(server.URL), method)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("GET"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_convai_tool.weather", "id", "tool-webhook"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_tool.weather", "type", "webhook"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_tool.weather", "webhook.secret_headers.Authorization", "secret-123"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_tool.weather", "webhook.dynamic_variable_headers.X-User", "user_id"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_tool.weather", "webhook.query_params.units.required", "true"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_tool.weather", "webhook.content_type", "application/json"),
+				),
+			},
+			{
+				Config: config("POST"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_convai_tool.weather", "webhook.method", "POST"),
+				),
+			},
+			{
+				ResourceName:            "elevenlabs_convai_tool.weather",
+				ImportState:             true,
+				ImportStateId:           "tool-webhook",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"workspace"},
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_convai_tool" "weather" {
+  name = "get_weather"
+
+  client = {}
+  system = { system_tool_type = "end_call" }
+}
+`, testAccProviderConfig(server.URL)),
+				ExpectError: regexp.MustCompile(`Only one of webhook, client or system may be set`),
+			},
+		},
+	})
+}
+
+func TestExpandConvAIToolConfig(t *testing.T) {
+	ctx := context.Background()
+
+	system := ConvAIToolResourceModel{
+		Name:        types.StringValue("transfer"),
+		Description: types.StringValue(""),
+		Type:        types.StringValue("system"),
+		System: &ConvAISystemToolModel{
+			SystemToolType: types.StringValue("transfer_to_agent"),
+			Params:         types.StringValue(`{"transfers":[{"agent_id":"agent-1","condition":"billing"}]}`),
+		},
+	}
+	config, diags := expandConvAIToolConfig(ctx, &system)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if config.Type != "system" || config.Params["system_tool_type"] != "transfer_to_agent" || config.Params["transfers"] == nil {
+		t.Errorf("Expected system params to include the tool type and transfers, got %+v", config.Params)
+	}
+
+	var state ConvAIToolResourceModel
+	if diags := applyConvAIToolToState(ctx, &state, &models.ConvAITool{ToolID: "tool-1", ToolConfig: config}); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if state.System == nil || state.System.SystemToolType.ValueString() != "transfer_to_agent" {
+		t.Errorf("Expected system_tool_type to be read back, got %+v", state.System)
+	}
+	if state.System.Params.ValueString() != `{"transfers":[{"agent_id":"agent-1","condition":"billing"}]}` {
+		t.Errorf("Expected params without system_tool_type, got %s", state.System.Params.ValueString())
+	}
+
+	invalid := ConvAIToolResourceModel{
+		Name:   types.StringValue("client"),
+		Type:   types.StringValue("client"),
+		Client: &ConvAIClientToolModel{Parameters: types.StringValue(`[1, 2]`), ExpectsResponse: types.BoolValue(false)},
+	}
+	if _, diags := expandConvAIToolConfig(ctx, &invalid); !diags.HasError() {
+		t.Errorf("Expected non-object parameters to be rejected")
+	}
+}
+
+func TestAccConvAIToolResourceDependentAgents(t *testing.T) {
+	var mu sync.Mutex
+	toolIDs := []interface{}{"tool-other", "tool-dep"}
+	deleted := false
+
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodPost,
+			Path:   "/convai/tools",
+			Body:   `{"id":"tool-dep","tool_config":{"type":"client","name":"lookup","description":""}}`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/tools/tool-dep",
+			Body:   `{"id":"tool-dep","tool_config":{"type":"client","name":"lookup","description":""}}`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/tools/tool-dep/dependent-agents",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				agents := []models.ConvAIDependentAgent{}
+				for _, id := range toolIDs {
+					if id == "tool-dep" {
+						agents = append(agents, models.ConvAIDependentAgent{ID: "agent-1", Name: "Support", Type: "available", AccessLevel: "admin", CreatedAtUnixSecs: 1700000000})
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.ConvAIDependentAgentsResponse{Agents: agents})
+			},
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/convai/agents/agent-1",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"agent_id":            "agent-1",
+					"conversation_config": map[string]interface{}{"agent": map[string]interface{}{"prompt": map[string]interface{}{"prompt": "Help", "tool_ids": toolIDs}}},
+				})
+			},
+		},
+		{
+			Method: httpMethodPatch,
+			Path:   "/convai/agents/agent-1",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					ConversationConfig struct {
+						Agent struct {
+							Prompt struct {
+								Prompt  string        `json:"prompt"`
+								ToolIDs []interface{} `json:"tool_ids"`
+							} `json:"prompt"`
+						} `json:"agent"`
+					} `json:"conversation_config"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				mu.Lock()
+				defer mu.Unlock()
+				if body.ConversationConfig.Agent.Prompt.Prompt != "Help" {
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					return
+				}
+				toolIDs = body.ConversationConfig.Agent.Prompt.ToolIDs
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{}`))
+			},
+		},
+		{
+			Method: httpMethodDelete,
+			Path:   "/convai/tools/tool-dep",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				deleted = true
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{}`))
+			},
+		},
+	})
+	defer server.Close()
+
+	config := func(forceDetach bool) string {
+		return fmt.Sprintf(`
+%s
+
+resource "elevenlabs_convai_tool" "lookup" {
+  name         = "lookup"
+  force_detach = %t
+}
+
+data "elevenlabs_convai_tool_dependent_agents" "lookup" {
+  tool_id = elevenlabs_convai_tool.lookup.id
+}
+`, testAccProviderConfig(server.URL), forceDetach)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if !deleted || len(toolIDs) != 1 || toolIDs[0] != "tool-other" {
+				return fmt.Errorf("expected the tool to be detached and deleted, got deleted=%t tool_ids=%v", deleted, toolIDs)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_tool_dependent_agents.lookup", "agent_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_tool_dependent_agents.lookup", "agents.0.name", "Support"),
+					resource.TestCheckResourceAttr("data.elevenlabs_convai_tool_dependent_agents.lookup", "inaccessible_agent_count", "0"),
+				),
+			},
+			{
+				Config:      testAccProviderConfig(server.URL),
+				ExpectError: regexp.MustCompile(`"Support" \(agent-1\)`),
+			},
+			{
+				Config: config(true),
+			},
+		},
+	})
+}
+
+func TestRemoveToolFromConversationConfig(t *testing.T) {
+	conversationConfig := map[string]interface{}{
+		"agent": map[string]interface{}{
+			"prompt": map[string]interface{}{"tool_ids": []interface{}{"tool-1", "tool-2"}},
+		},
+	}
+
+	if !removeToolFromConversationConfig(conversationConfig, "tool-1") {
+		t.Fatalf("Expected tool-1 to be removed")
+	}
+	toolIDs := conversationConfig["agent"].(map[string]interface{})["prompt"].(map[string]interface{})["tool_ids"].([]interface{})
+	if len(toolIDs) != 1 || toolIDs[0] != "tool-2" {
+		t.Errorf("Expected only tool-2 to remain, got %v", toolIDs)
+	}
+
+	if removeToolFromConversationConfig(conversationConfig, "tool-3") {
+		t.Errorf("Expected a missing tool not to be reported as removed")
+	}
+	if removeToolFromConversationConfig(map[string]interface{}{}, "tool-1") {
+		t.Errorf("Expected an empty config not to be changed")
+	}
+
+	message := describeDependentAgents([]models.ConvAIDependentAgent{{ID: "agent-1", Name: "Support", Type: "available"}, {Type: "unknown"}})
+	if message != `"Support" (agent-1), 1 agent(s) you do not have access to` {
+		t.Errorf("Unexpected description: %s", message)
+	}
+}
@@ -111,9 +111,9 @@ func flattenConvAITools(ctx context.Context, tools []models.ConvAITool) (types.L
 
 		obj, objDiags := types.ObjectValue(convAIToolAttrTypes, map[string]attr.Value{
 			"tool_id":             types.StringValue(tool.ToolID),
-			"name":                types.StringValue(tool.Name),
-			"description":         types.StringValue(tool.Description),
-			"parameters_json":     jsonStringValue(tool.Parameters),
+			"name":                types.StringValue(tool.ToolConfig.Name),
+			"description":         types.StringValue(tool.ToolConfig.Description),
+			"parameters_json":     jsonStringValue(tool.ToolConfig.Parameters),
 			"dependent_agent_ids": agentIds,
 			"access_info_json":    jsonStringValue(tool.AccessInfo),
 			"tool_config_json":    jsonStringValue(tool.ToolConfig),
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,9 +16,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &ConvAIWhatsAppAccountResource{}
-	_ resource.ResourceWithConfigure   = &ConvAIWhatsAppAccountResource{}
-	_ resource.ResourceWithImportState = &ConvAIWhatsAppAccountResource{}
+	_ resource.Resource                   = &ConvAIWhatsAppAccountResource{}
+	_ resource.ResourceWithConfigure      = &ConvAIWhatsAppAccountResource{}
+	_ resource.ResourceWithImportState    = &ConvAIWhatsAppAccountResource{}
+	_ resource.ResourceWithValidateConfig = &ConvAIWhatsAppAccountResource{}
 )
 
 func NewConvAIWhatsAppAccountResource() resource.Resource {
@@ -32,6 +34,8 @@ type ConvAIWhatsAppAccountResourceModel struct {
 	PhoneNumberID     types.String `tfsdk:"phone_number_id"`
 	BusinessAccountID types.String `tfsdk:"business_account_id"`
 	TokenCode         types.String `tfsdk:"token_code"`
+	TokenCodeWO       types.String `tfsdk:"token_code_wo"`
+	TokenCodeVersion  types.Int64  `tfsdk:"token_code_version"`
 	AssignedAgentID   types.String `tfsdk:"assigned_agent_id"`
 
 	// Computed
@@ -39,6 +43,7 @@ type ConvAIWhatsAppAccountResourceModel struct {
 	PhoneNumberName     types.String `tfsdk:"phone_number_name"`
 	PhoneNumber         types.String `tfsdk:"phone_number"`
 	AssignedAgentName   types.String `tfsdk:"assigned_agent_name"`
+	Workspace           types.String `tfsdk:"workspace"`
 }
 
 func (r *ConvAIWhatsAppAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,12 +69,25 @@ func (r *ConvAIWhatsAppAccountResource) Schema(ctx context.Context, req resource
 				MarkdownDescription: "Business account ID from your WhatsApp Business API.",
 			},
 			"token_code": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
-				MarkdownDescription: "Token code from WhatsApp Business API setup flow.",
+				MarkdownDescription: "Token code from WhatsApp Business API setup flow. Stored in state; prefer `token_code_wo`. Exactly one of `token_code` or `token_code_wo` must be set.",
+			},
+			"token_code_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Write-only token code from WhatsApp Business API setup flow that is never stored in state. Requires Terraform 1.11 or later.",
+			},
+			"token_code_version": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Version of `token_code_wo`. Increment to re-import the account with a new token code.",
 			},
 			"assigned_agent_id": schema.StringAttribute{
 				Optional:            true,
@@ -91,6 +109,7 @@ func (r *ConvAIWhatsAppAccountResource) Schema(ctx context.Context, req resource
 				Computed:            true,
 				MarkdownDescription: "Name of the assigned ConvAI agent.",
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -112,6 +131,10 @@ func (r *ConvAIWhatsAppAccountResource) Configure(ctx context.Context, req resou
 	r.client = client
 }
 
+func (r *ConvAIWhatsAppAccountResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateWriteOnlyAlternative(ctx, req.Config, "token_code", &resp.Diagnostics)
+}
+
 func (r *ConvAIWhatsAppAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ConvAIWhatsAppAccountResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -119,13 +142,30 @@ func (r *ConvAIWhatsAppAccountResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tokenCodeWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token_code_wo"), &tokenCodeWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenCode := data.TokenCode.ValueString()
+	if !tokenCodeWO.IsNull() {
+		tokenCode = tokenCodeWO.ValueString()
+	}
+
 	addReq := &models.ImportWhatsAppAccountRequest{
 		BusinessAccountID: data.BusinessAccountID.ValueString(),
 		PhoneNumberID:     data.PhoneNumberID.ValueString(),
-		TokenCode:         data.TokenCode.ValueString(),
+		TokenCode:         tokenCode,
 	}
 
-	account, err := r.client.ImportConvAIWhatsAppAccount(addReq)
+	account, err := c.ImportConvAIWhatsAppAccount(addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error importing ConvAI WhatsApp account", err.Error())
 		return
@@ -149,7 +189,13 @@ func (r *ConvAIWhatsAppAccountResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	account, err := r.client.GetConvAIWhatsAppAccount(data.PhoneNumberID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := c.GetConvAIWhatsAppAccount(data.PhoneNumberID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ConvAI WhatsApp account", err.Error())
 		return
@@ -173,6 +219,12 @@ func (r *ConvAIWhatsAppAccountResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := &models.UpdateWhatsAppAccountRequest{}
 	if !data.AssignedAgentID.IsNull() && !data.AssignedAgentID.IsUnknown() {
 		agentID := data.AssignedAgentID.ValueString()
@@ -181,7 +233,7 @@ func (r *ConvAIWhatsAppAccountResource) Update(ctx context.Context, req resource
 		updateReq.AssignedAgentID = nil
 	}
 
-	account, err := r.client.UpdateConvAIWhatsAppAccount(data.PhoneNumberID.ValueString(), updateReq)
+	account, err := c.UpdateConvAIWhatsAppAccount(data.PhoneNumberID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating ConvAI WhatsApp account", err.Error())
 		return
@@ -205,7 +257,13 @@ func (r *ConvAIWhatsAppAccountResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	err := r.client.DeleteConvAIWhatsAppAccount(data.PhoneNumberID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteConvAIWhatsAppAccount(data.PhoneNumberID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting ConvAI WhatsApp account", err.Error())
 		return
@@ -213,5 +271,5 @@ func (r *ConvAIWhatsAppAccountResource) Delete(ctx context.Context, req resource
 }
 
 func (r *ConvAIWhatsAppAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("phone_number_id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("phone_number_id"), req, resp)
 }
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccConvAIWhatsAppAccountResourceWriteOnly(t *testing.T) {
+	var tokenCodes []string
+	account := `{"business_account_id":"ba-123","business_account_name":"Biz","phone_number_id":"wa-123","phone_number_name":"WA","phone_number":"+456"}`
+
+	server := newTestServer(t, []testRoute{
+		{
+			Method: http.MethodPost,
+			Path:   "/convai/whatsapp-accounts",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]string
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				tokenCodes = append(tokenCodes, body["token_code"])
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(account))
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/convai/whatsapp-accounts/wa-123",
+			Body:   account,
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/convai/whatsapp-accounts/wa-123",
+		},
+	})
+	defer server.Close()
+
+	config := func(tokenCode string, version int) string {
+		return fmt.Sprintf(`
+%s
+
+resource "elevenlabs_convai_whatsapp_account" "test" {
+  phone_number_id     = "wa-123"
+  business_account_id = "ba-123"
+  token_code_wo       = "%s"
+  token_code_version  = %d
+}
+`, testAccProviderConfig(server.URL), tokenCode, version)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("first-token", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_convai_whatsapp_account.test", "phone_number_id", "wa-123"),
+					resource.TestCheckNoResourceAttr("elevenlabs_convai_whatsapp_account.test", "token_code_wo"),
+					resource.TestCheckNoResourceAttr("elevenlabs_convai_whatsapp_account.test", "token_code"),
+				),
+			},
+			{
+				// A new token code without a new version is not sent.
+				Config: config("second-token", 1),
+				Check: func(*terraform.State) error {
+					if len(tokenCodes) != 1 || tokenCodes[0] != "first-token" {
+						return fmt.Errorf("unexpected token codes sent to the API: %v", tokenCodes)
+					}
+					return nil
+				},
+			},
+			{
+				Config: config("second-token", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("elevenlabs_convai_whatsapp_account.test", "token_code_wo"),
+					func(*terraform.State) error {
+						if len(tokenCodes) != 2 || tokenCodes[1] != "second-token" {
+							return fmt.Errorf("unexpected token codes sent to the API: %v", tokenCodes)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultSharedCredentialsFile = "~/.elevenlabs/credentials"
+
+// apiKeySource lists the places an API key may be read from, in order of precedence.
+type apiKeySource struct {
+	APIKey          types.String
+	APIKeyFile      types.String
+	Profile         types.String
+	EnvVar          string
+	EnvFileVar      string
+	EnvProfile      string
+	FallbackProfile string
+}
+
+// resolveAPIKey returns the API key for src. Configured attributes are
+// checked first (api_key, api_key_file, profile), then the environment
+// (EnvFileVar, EnvProfile, EnvVar), then the fallback profile. The shared
+// credentials file is only read when a profile is requested or no other
+// source provides a key.
+func resolveAPIKey(src apiKeySource, credentialsFile string) (string, error) {
+	if !src.APIKey.IsNull() && src.APIKey.ValueString() != "" {
+		return src.APIKey.ValueString(), nil
+	}
+	if keyFile := src.APIKeyFile.ValueString(); keyFile != "" {
+		return readAPIKeyFile(keyFile)
+	}
+	if profile := src.Profile.ValueString(); profile != "" {
+		return sharedCredentialsProfile(credentialsFile, profile)
+	}
+
+	if src.EnvFileVar != "" {
+		if keyFile := os.Getenv(src.EnvFileVar); keyFile != "" {
+			return readAPIKeyFile(keyFile)
+		}
+	}
+	if src.EnvProfile != "" {
+		if profile := os.Getenv(src.EnvProfile); profile != "" {
+			return sharedCredentialsProfile(credentialsFile, profile)
+		}
+	}
+	if src.EnvVar != "" {
+		if key := os.Getenv(src.EnvVar); key != "" {
+			return key, nil
+		}
+	}
+
+	if src.FallbackProfile != "" {
+		profiles, err := loadSharedCredentials(credentialsFile)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return "", nil
+			}
+			return "", err
+		}
+		return profiles[src.FallbackProfile], nil
+	}
+
+	return "", nil
+}
+
+// sharedCredentialsProfile returns the api_key of an explicitly requested
+// profile, failing if the profile does not exist.
+func sharedCredentialsProfile(credentialsFile, profile string) (string, error) {
+	profiles, err := loadSharedCredentials(credentialsFile)
+	if err != nil {
+		return "", err
+	}
+	key, ok := profiles[profile]
+	if !ok {
+		return "", fmt.Errorf("profile %q not found in shared credentials file %s", profile, credentialsFile)
+	}
+	return key, nil
+}
+
+// workspaceEnvVar returns the environment variable holding the API key for
+// the named workspace, e.g. ELEVENLABS_API_KEY_STAGING for "staging".
+func workspaceEnvVar(name string) string {
+	var b strings.Builder
+	b.WriteString("ELEVENLABS_API_KEY_")
+	for _, r := range strings.ToUpper(name) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
+
+func readAPIKeyFile(path string) (string, error) {
+	data, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return "", fmt.Errorf("reading API key file: %w", err)
+	}
+
+	key := strings.TrimSpace(string(data))
+	if key == "" {
+		return "", fmt.Errorf("API key file %s is empty", path)
+	}
+	return key, nil
+}
+
+// loadSharedCredentials parses an INI style credentials file and returns the
+// api_key of every profile:
+//
+//	[default]
+//	api_key = sk_...
+//
+//	[staging]
+//	api_key = sk_...
+func loadSharedCredentials(path string) (map[string]string, error) {
+	file, err := os.Open(expandHome(path))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close() //nolint:errcheck
+
+	profiles := make(map[string]string)
+	profile := ""
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			profile = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key = value", path, lineNumber)
+		}
+		if profile == "" {
+			return nil, fmt.Errorf("%s:%d: %s is not inside a [profile] section", path, lineNumber, strings.TrimSpace(key))
+		}
+		if strings.TrimSpace(key) == "api_key" {
+			profiles[profile] = strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
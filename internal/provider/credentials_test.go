@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestResolveAPIKey(t *testing.T) {
+	credentialsFile := writeTempFile(t, "credentials", []byte(`
+# ElevenLabs credentials
+[default]
+api_key = default-key
+
+[staging]
+api_key = "staging-key"
+`))
+	keyFile := writeTempFile(t, "api_key", []byte("file-key\n"))
+
+	t.Setenv("ELEVENLABS_TEST_API_KEY", "")
+	t.Setenv("ELEVENLABS_TEST_PROFILE", "")
+	t.Setenv("ELEVENLABS_TEST_API_KEY_FILE", "")
+
+	tests := []struct {
+		name     string
+		src      apiKeySource
+		env      map[string]string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "api_key wins",
+			src:      apiKeySource{APIKey: types.StringValue("config-key"), APIKeyFile: types.StringValue(keyFile)},
+			expected: "config-key",
+		},
+		{
+			name:     "api_key_file",
+			src:      apiKeySource{APIKey: types.StringNull(), APIKeyFile: types.StringValue(keyFile)},
+			expected: "file-key",
+		},
+		{
+			name:     "profile",
+			src:      apiKeySource{Profile: types.StringValue("staging"), EnvVar: "ELEVENLABS_TEST_API_KEY"},
+			env:      map[string]string{"ELEVENLABS_TEST_API_KEY": "env-key"},
+			expected: "staging-key",
+		},
+		{
+			name:     "profile before environment api_key_file",
+			src:      apiKeySource{Profile: types.StringValue("staging"), EnvFileVar: "ELEVENLABS_TEST_API_KEY_FILE"},
+			env:      map[string]string{"ELEVENLABS_TEST_API_KEY_FILE": keyFile},
+			expected: "staging-key",
+		},
+		{
+			name:     "environment api_key_file",
+			src:      apiKeySource{EnvFileVar: "ELEVENLABS_TEST_API_KEY_FILE", EnvProfile: "ELEVENLABS_TEST_PROFILE"},
+			env:      map[string]string{"ELEVENLABS_TEST_API_KEY_FILE": keyFile, "ELEVENLABS_TEST_PROFILE": "staging"},
+			expected: "file-key",
+		},
+		{
+			name:     "profile from environment",
+			src:      apiKeySource{EnvProfile: "ELEVENLABS_TEST_PROFILE"},
+			env:      map[string]string{"ELEVENLABS_TEST_PROFILE": "staging"},
+			expected: "staging-key",
+		},
+		{
+			name:     "environment before fallback profile",
+			src:      apiKeySource{EnvVar: "ELEVENLABS_TEST_API_KEY", FallbackProfile: "default"},
+			env:      map[string]string{"ELEVENLABS_TEST_API_KEY": "env-key"},
+			expected: "env-key",
+		},
+		{
+			name:     "fallback profile",
+			src:      apiKeySource{EnvVar: "ELEVENLABS_TEST_API_KEY", FallbackProfile: "default"},
+			expected: "default-key",
+		},
+		{
+			name: "missing profile",
+			src:  apiKeySource{Profile: types.StringValue("production")},
+			err:  true,
+		},
+		{
+			name: "missing api_key_file",
+			src:  apiKeySource{APIKeyFile: types.StringValue(keyFile + ".missing")},
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			key, err := resolveAPIKey(tt.src, credentialsFile)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("expected error, got key %q", key)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if key != tt.expected {
+				t.Errorf("expected key %q, got %q", tt.expected, key)
+			}
+		})
+	}
+}
+
+func TestWorkspaceEnvVar(t *testing.T) {
+	if got := workspaceEnvVar("us-east.prod"); got != "ELEVENLABS_API_KEY_US_EAST_PROD" {
+		t.Errorf("unexpected environment variable %q", got)
+	}
+}
+
+func TestAccProviderValidateCredentials(t *testing.T) {
+	server := newTestServer(t, []testRoute{
+		{
+			Method: http.MethodGet,
+			Path:   "/user",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("xi-api-key") != "valid-key" {
+					http.Error(w, `{"detail":{"status":"invalid_api_key"}}`, http.StatusUnauthorized)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"user_id":"user-123","subscription":{"tier":"creator"}}`))
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/models",
+			Body:   `[]`,
+		},
+	})
+	defer server.Close()
+
+	credentialsFile := writeTempFile(t, "credentials", []byte("[shared]\napi_key = revoked-key\n"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "elevenlabs" {
+  api_key              = "valid-key"
+  base_url             = "%s"
+  validate_credentials = true
+}
+
+data "elevenlabs_models" "all" {}
+`, server.URL),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "elevenlabs" {
+  api_key                 = "valid-key"
+  base_url                = "%s"
+  shared_credentials_file = "%s"
+  validate_credentials    = true
+
+  workspaces = {
+    shared = {}
+  }
+}
+
+data "elevenlabs_models" "all" {}
+`, server.URL, credentialsFile),
+				ExpectError: regexp.MustCompile(`workspace "shared" was rejected`),
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testAccProtoV6ProviderFactoriesWithEcho adds the echo provider, which
+// exposes ephemeral values through its state for test checks.
+var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"elevenlabs": providerserver.NewProtocol6WithError(New("test")()),
+	"echo":       echoprovider.NewProviderServer(),
+}
+
+func TestAccConvAIEphemeralResources(t *testing.T) {
+	server := newTestServer(t, []testRoute{
+		{
+			Method: http.MethodGet,
+			Path:   "/convai/conversation/get-signed-url",
+			Body:   `{"signed_url":"wss://api.elevenlabs.io/v1/convai/conversation?agent_id=agent-123&conversation_signature=sig-123"}`,
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/convai/conversation/token",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("agent_id") != "agent-123" || r.URL.Query().Get("participant_name") != "ci" {
+					http.Error(w, "Bad Request", http.StatusBadRequest)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"token":"token-123"}`))
+			},
+		},
+	})
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+ephemeral "elevenlabs_convai_signed_url" "test" {
+  agent_id = "agent-123"
+}
+
+ephemeral "elevenlabs_convai_conversation_token" "test" {
+  agent_id         = "agent-123"
+  participant_name = "ci"
+}
+
+provider "echo" {
+  data = {
+    signed_url = ephemeral.elevenlabs_convai_signed_url.test.signed_url
+    token      = ephemeral.elevenlabs_convai_conversation_token.test.token
+  }
+}
+
+resource "echo" "test" {}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.signed_url", "wss://api.elevenlabs.io/v1/convai/conversation?agent_id=agent-123&conversation_signature=sig-123"),
+					resource.TestCheckResourceAttr("echo.test", "data.token", "token-123"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSingleUseTokenEphemeralResource(t *testing.T) {
+	server := newTestServer(t, []testRoute{
+		{
+			Method: http.MethodPost,
+			Path:   "/single-use-token/tts_websocket",
+			Body:   `{"token":"sutkn_123"}`,
+		},
+	})
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+ephemeral "elevenlabs_single_use_token" "test" {
+  token_type = "tts_websocket"
+}
+
+provider "echo" {
+  data = ephemeral.elevenlabs_single_use_token.test.token
+}
+
+resource "echo" "test" {}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.TestCheckResourceAttr("echo.test", "data", "sutkn_123"),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+ephemeral "elevenlabs_single_use_token" "test" {
+  token_type = "speech_to_speech"
+}
+
+provider "echo" {
+  data = ephemeral.elevenlabs_single_use_token.test.token
+}
+
+resource "echo" "test" {}
+`, testAccProviderConfig(server.URL)),
+				ExpectError: regexp.MustCompile(`Invalid Token Type`),
+			},
+		},
+	})
+}
+
+func TestSingleUseTokenEphemeralResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := NewSingleUseTokenEphemeralResource()
+
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	for tokenType, wantError := range map[string]bool{
+		"tts_websocket":    false,
+		"realtime_scribe":  false,
+		"speech_to_speech": true,
+	} {
+		config := tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"token_type": tftypes.NewValue(tftypes.String, tokenType),
+				"token":      tftypes.NewValue(tftypes.String, nil),
+				"workspace":  tftypes.NewValue(tftypes.String, nil),
+			}),
+		}
+
+		var resp ephemeral.ValidateConfigResponse
+		r.(ephemeral.EphemeralResourceWithValidateConfig).ValidateConfig(ctx, ephemeral.ValidateConfigRequest{Config: config}, &resp)
+		if resp.Diagnostics.HasError() != wantError {
+			t.Errorf("token_type %q: expected error %v, got %v", tokenType, wantError, resp.Diagnostics)
+		}
+	}
+}
@@ -35,6 +35,7 @@ type ProjectResourceModel struct {
 	DefaultParagraphVoiceID types.String `tfsdk:"default_paragraph_voice_id"`
 	DefaultTitleVoiceID     types.String `tfsdk:"default_title_voice_id"`
 	State                   types.String `tfsdk:"state"`
+	Workspace               types.String `tfsdk:"workspace"`
 }
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -78,6 +79,7 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"state": schema.StringAttribute{
 				Computed: true,
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -106,6 +108,12 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createReq := &models.CreateProjectRequest{
 		Name:                    data.Name.ValueString(),
 		DefaultModelID:          data.DefaultModelID.ValueString(),
@@ -113,7 +121,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		DefaultTitleVoiceID:     data.DefaultTitleVoiceID.ValueString(),
 	}
 
-	project, err := r.client.CreateProject(createReq)
+	project, err := c.CreateProject(createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating project", err.Error())
 		return
@@ -132,7 +140,13 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	project, err := r.client.GetProject(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := c.GetProject(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading project", err.Error())
 		return
@@ -161,7 +175,13 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteProject(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteProject(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting project", err.Error())
 		return
@@ -169,5 +189,5 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
 }
@@ -17,7 +17,7 @@ func TestAccPronunciationAndAudioResources(t *testing.T) {
 	server := newTestServer(t, []testRoute{
 		{
 			Method: httpMethodPost,
-			Path:   "/pronunciation-dictionaries/add-from-rules",
+			Path:   "/pronunciation-dictionaries/add-from-file",
 			Body:   `{"id":"dict-123","name":"Test Dict","latest_version_id":"version-1"}`,
 		},
 		{
@@ -33,6 +33,7 @@ func TestAccPronunciationAndAudioResources(t *testing.T) {
 		{
 			Method: httpMethodPost,
 			Path:   "/pronunciation-dictionaries/dict-123/add-rules",
+			Body:   `{"id":"dict-123","version_id":"version-2","version_rules_num":2}`,
 		},
 		{
 			Method: httpMethodPatch,
@@ -41,7 +42,7 @@ func TestAccPronunciationAndAudioResources(t *testing.T) {
 		{
 			Method: httpMethodGet,
 			Path:   "/pronunciation-dictionaries/dict-123/version-1/download",
-			Body:   `"dGVzdA=="`,
+			Body:   `<?xml version="1.0" encoding="UTF-8"?><lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US"></lexicon>`,
 		},
 		{
 			Method: httpMethodPost,
@@ -51,11 +52,12 @@ func TestAccPronunciationAndAudioResources(t *testing.T) {
 		{
 			Method: httpMethodGet,
 			Path:   "/audio-native/audio-123/settings",
-			Body:   `{"title":"Test Title","author":"Test Author","text_color":"#000000","background_color":"#ffffff","status":"ready"}`,
+			Body:   `{"enabled":true,"snapshot_id":"snap-1","settings":{"title":"Test Title","author":"Test Author","text_color":"#000000","background_color":"#ffffff","status":"ready"}}`,
 		},
 		{
 			Method: httpMethodPost,
 			Path:   "/audio-native/audio-123/content",
+			Body:   `{"project_id":"audio-123","converting":false,"publishing":false,"html_snippet":"<div>audio</div>"}`,
 		},
 		{
 			Method: httpMethodDelete,
@@ -134,6 +136,7 @@ resource "elevenlabs_voice_sample" "sample" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary.dict", "id", "dict-123"),
 					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary.dict", "latest_version_id", "version-1"),
+					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary_rules.dict_rules", "version_id", "version-2"),
 					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_dictionaries.all", "dictionaries.#", "1"),
 					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_dictionary_download.pls", "file_name", "dict.pls"),
 					resource.TestCheckResourceAttr("elevenlabs_audio_native.audio", "id", "audio-123"),
@@ -3,21 +3,25 @@ package provider
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/pls"
 )
 
 var (
-	_ resource.Resource                = &PronunciationDictionaryResource{}
-	_ resource.ResourceWithConfigure   = &PronunciationDictionaryResource{}
-	_ resource.ResourceWithImportState = &PronunciationDictionaryResource{}
+	_ resource.Resource                   = &PronunciationDictionaryResource{}
+	_ resource.ResourceWithConfigure      = &PronunciationDictionaryResource{}
+	_ resource.ResourceWithImportState    = &PronunciationDictionaryResource{}
+	_ resource.ResourceWithValidateConfig = &PronunciationDictionaryResource{}
 )
 
 func NewPronunciationDictionaryResource() resource.Resource {
@@ -35,6 +39,8 @@ type PronunciationDictionaryResourceModel struct {
 	LatestVersionID types.String `tfsdk:"latest_version_id"`
 	FilePath        types.String `tfsdk:"file_path"`
 	Rules           []RuleModel  `tfsdk:"rules"`
+	Language        types.String `tfsdk:"language"`
+	Workspace       types.String `tfsdk:"workspace"`
 }
 
 type RuleModel struct {
@@ -73,7 +79,11 @@ func (r *PronunciationDictionaryResource) Schema(ctx context.Context, req resour
 				MarkdownDescription: "Path to a .pls file. Mutually exclusive with `rules`.",
 			},
 			"rules": schema.ListNestedAttribute{
-				Optional: true,
+				Optional:            true,
+				MarkdownDescription: "Inline rules. They are validated and rendered to a PLS lexicon before upload. Mutually exclusive with `file_path`. Changing the rules forces a new dictionary; use `elevenlabs_pronunciation_dictionary_rule_set` to manage rules in place.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"type": schema.StringAttribute{
@@ -90,11 +100,20 @@ func (r *PronunciationDictionaryResource) Schema(ctx context.Context, req resour
 							Optional: true,
 						},
 						"alphabet": schema.StringAttribute{
-							Optional: true,
+							Optional:            true,
+							MarkdownDescription: "Phonetic alphabet for `phoneme` rules: `ipa` or `cmu-arpabet`.",
 						},
 					},
 				},
 			},
+			"language": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Language tag written to the generated lexicon when using `rules`. Defaults to `en-US`. Changing it forces a new dictionary.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -116,6 +135,27 @@ func (r *PronunciationDictionaryResource) Configure(ctx context.Context, req res
 	r.client = client
 }
 
+func (r *PronunciationDictionaryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var list types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rules"), &list)...)
+	if resp.Diagnostics.HasError() || list.IsNull() || list.IsUnknown() {
+		return
+	}
+	for _, element := range list.Elements() {
+		if element.IsUnknown() {
+			return
+		}
+	}
+
+	var rules []RuleModel
+	resp.Diagnostics.Append(list.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validatePronunciationRules(rules, &resp.Diagnostics)
+}
+
 func (r *PronunciationDictionaryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data PronunciationDictionaryResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -123,33 +163,49 @@ func (r *PronunciationDictionaryResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var dict *models.PronunciationDictionary
 	var err error
 
 	if !data.FilePath.IsNull() {
+		var content []byte
+		content, err = os.ReadFile(data.FilePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading PLS file", err.Error())
+			return
+		}
+		// Lexicon files are uploaded as written; the API is the authority on
+		// what it accepts, so only rules rendered from `rules` are validated.
 		addReq := &models.AddPronunciationDictionaryFromFileRequest{
 			Name:        data.Name.ValueString(),
 			Description: data.Description.ValueString(),
 			FilePath:    data.FilePath.ValueString(),
+			Content:     content,
 		}
-		dict, err = r.client.AddPronunciationDictionaryFromFile(addReq)
+		dict, err = c.AddPronunciationDictionaryFromFile(addReq)
 	} else if len(data.Rules) > 0 {
-		rules := make([]models.PronunciationRule, len(data.Rules))
-		for i, rule := range data.Rules {
-			rules[i] = models.PronunciationRule{
-				Type:            rule.Type.ValueString(),
-				StringToReplace: rule.StringToReplace.ValueString(),
-				Alias:           rule.Alias.ValueString(),
-				Phoneme:         rule.Phoneme.ValueString(),
-				Alphabet:        rule.Alphabet.ValueString(),
-			}
+		var content []byte
+		content, err = pls.Encode(&pls.Lexicon{
+			Language: data.Language.ValueString(),
+			Rules:    pronunciationRulesFromModels(data.Rules),
+		})
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rules"), "Invalid Pronunciation Rule", err.Error())
+			return
 		}
-		addReq := &models.AddPronunciationDictionaryFromRulesRequest{
+
+		addReq := &models.AddPronunciationDictionaryFromFileRequest{
 			Name:        data.Name.ValueString(),
 			Description: data.Description.ValueString(),
-			Rules:       rules,
+			FilePath:    "dictionary.pls",
+			Content:     content,
 		}
-		dict, err = r.client.AddPronunciationDictionaryFromRules(addReq)
+		dict, err = c.AddPronunciationDictionaryFromFile(addReq)
 	} else {
 		resp.Diagnostics.AddError("Invalid Configuration", "Either `file_path` or `rules` must be provided.")
 		return
@@ -173,7 +229,13 @@ func (r *PronunciationDictionaryResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	dict, err := r.client.GetPronunciationDictionary(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dict, err := c.GetPronunciationDictionary(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading pronunciation dictionary", err.Error())
 		return
@@ -199,7 +261,13 @@ func (r *PronunciationDictionaryResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	err := r.client.ArchivePronunciationDictionary(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.ArchivePronunciationDictionary(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error archiving pronunciation dictionary", err.Error())
 		return
@@ -207,5 +275,5 @@ func (r *PronunciationDictionaryResource) Delete(ctx context.Context, req resour
 }
 
 func (r *PronunciationDictionaryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
 }
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPronunciationDictionaryResource_FileUploadedUnchanged(t *testing.T) {
+	// x-sampa is not checked by the provider, so the file must reach the API
+	// as written rather than being rejected or re-encoded.
+	lexicon := `<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="x-sampa" xml:lang="en-US">
+  <lexeme><grapheme>tomato</grapheme><phoneme>t@"meIt@U</phoneme></lexeme>
+</lexicon>
+`
+	lexiconFile := writeTempFile(t, "dictionary.pls", []byte(lexicon))
+
+	var mu sync.Mutex
+	var uploaded string
+
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodPost,
+			Path:   "/pronunciation-dictionaries/add-from-file",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				file, _, err := r.FormFile("file")
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				defer file.Close() //nolint:errcheck
+				content, _ := io.ReadAll(file)
+				mu.Lock()
+				uploaded = string(content)
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":"dict-123","name":"Tomatoes","latest_version_id":"version-1"}`))
+			},
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/pronunciation-dictionaries/dict-123",
+			Body:   `{"id":"dict-123","name":"Tomatoes","latest_version_id":"version-1"}`,
+		},
+		{
+			Method: httpMethodPatch,
+			Path:   "/pronunciation-dictionaries/dict-123",
+		},
+	})
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_pronunciation_dictionary" "dict" {
+  name      = "Tomatoes"
+  file_path = %q
+}
+`, testAccProviderConfig(server.URL), lexiconFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary.dict", "id", "dict-123"),
+					func(_ *terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+						if uploaded != lexicon {
+							return fmt.Errorf("expected the lexicon file to be uploaded unchanged, got:\n%s", uploaded)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestPronunciationDictionaryResource_ValidateConfig(t *testing.T) {
+	ruleType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":              tftypes.String,
+		"string_to_replace": tftypes.String,
+		"alias":             tftypes.String,
+		"phoneme":           tftypes.String,
+		"alphabet":          tftypes.String,
+	}}
+	rule := func(kind, str, alias interface{}) tftypes.Value {
+		return tftypes.NewValue(ruleType, map[string]tftypes.Value{
+			"type":              tftypes.NewValue(tftypes.String, kind),
+			"string_to_replace": tftypes.NewValue(tftypes.String, str),
+			"alias":             tftypes.NewValue(tftypes.String, alias),
+			"phoneme":           tftypes.NewValue(tftypes.String, nil),
+			"alphabet":          tftypes.NewValue(tftypes.String, nil),
+		})
+	}
+	rules := func(elements ...tftypes.Value) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"name":  tftypes.NewValue(tftypes.String, "Brands"),
+			"rules": tftypes.NewValue(tftypes.List{ElementType: ruleType}, elements),
+		}
+	}
+
+	tests := map[string]struct {
+		values    map[string]tftypes.Value
+		wantError bool
+	}{
+		"valid":               {rules(rule("alias", "CA", "California")), false},
+		"alias without alias": {rules(rule("alias", "CA", nil)), true},
+		"duplicate string":    {rules(rule("alias", "CA", "California"), rule("alias", "CA", "Canada")), true},
+		"unknown rule":        {rules(tftypes.NewValue(ruleType, tftypes.UnknownValue)), false},
+	}
+
+	r := NewPronunciationDictionaryResource()
+	for name, tt := range tests {
+		diags := validateResourceConfig(t, r, tt.values)
+		if diags.HasError() != tt.wantError {
+			t.Errorf("%s: expected error %t, got %v", name, tt.wantError, diags)
+		}
+	}
+}
@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/pls"
+)
+
+var (
+	_ resource.Resource                   = &PronunciationDictionaryRuleSetResource{}
+	_ resource.ResourceWithConfigure      = &PronunciationDictionaryRuleSetResource{}
+	_ resource.ResourceWithImportState    = &PronunciationDictionaryRuleSetResource{}
+	_ resource.ResourceWithValidateConfig = &PronunciationDictionaryRuleSetResource{}
+)
+
+func NewPronunciationDictionaryRuleSetResource() resource.Resource {
+	return &PronunciationDictionaryRuleSetResource{}
+}
+
+type PronunciationDictionaryRuleSetResource struct {
+	client *client.Client
+}
+
+type PronunciationDictionaryRuleSetResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	DictionaryID    types.String `tfsdk:"dictionary_id"`
+	Rules           []RuleModel  `tfsdk:"rules"`
+	VersionID       types.String `tfsdk:"version_id"`
+	LatestVersionID types.String `tfsdk:"latest_version_id"`
+	Workspace       types.String `tfsdk:"workspace"`
+}
+
+func (r *PronunciationDictionaryRuleSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pronunciation_dictionary_rule_set"
+}
+
+func (r *PronunciationDictionaryRuleSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the rules of an ElevenLabs pronunciation dictionary. " +
+			"The latest dictionary version is downloaded and compared with `rules`, and only the difference is added or removed. " +
+			"Rules added outside Terraform are removed on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dictionary_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the pronunciation dictionary whose rules are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The complete set of rules the dictionary should contain.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Type of rule: `alias` or `phoneme`.",
+						},
+						"string_to_replace": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The text to replace. Must be unique within the set.",
+						},
+						"alias": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Replacement text for `alias` rules.",
+						},
+						"phoneme": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Pronunciation for `phoneme` rules.",
+						},
+						"alphabet": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Phonetic alphabet for `phoneme` rules: `ipa` or `cmu-arpabet`.",
+						},
+					},
+				},
+			},
+			"version_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The dictionary version produced by the last apply of this resource. Reference it to pin consumers to the rules managed here.",
+			},
+			"latest_version_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The latest version of the dictionary, which may be newer than `version_id` if the dictionary was changed elsewhere.",
+			},
+			"workspace": workspaceAttribute(),
+		},
+	}
+}
+
+func (r *PronunciationDictionaryRuleSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PronunciationDictionaryRuleSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	rules, ok := pronunciationRulesFromConfig(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	validatePronunciationRules(rules, &resp.Diagnostics)
+}
+
+func (r *PronunciationDictionaryRuleSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PronunciationDictionaryRuleSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(syncPronunciationDictionaryRules(c, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.DictionaryID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PronunciationDictionaryRuleSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PronunciationDictionaryRuleSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dict, current, err := currentPronunciationDictionaryRules(c, data.DictionaryID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading pronunciation dictionary rules", err.Error())
+		return
+	}
+
+	data.ID = data.DictionaryID
+	data.LatestVersionID = types.StringValue(dict.LatestVersionID)
+	if data.VersionID.IsNull() {
+		data.VersionID = types.StringValue(dict.LatestVersionID)
+	}
+	data.Rules = make([]RuleModel, len(current))
+	for i, rule := range current {
+		data.Rules[i] = RuleModel{
+			Type:            types.StringValue(rule.Type),
+			StringToReplace: types.StringValue(rule.StringToReplace),
+			Alias:           optionalStringValue(rule.Alias),
+			Phoneme:         optionalStringValue(rule.Phoneme),
+			Alphabet:        optionalStringValue(rule.Alphabet),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PronunciationDictionaryRuleSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PronunciationDictionaryRuleSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(syncPronunciationDictionaryRules(c, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.DictionaryID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PronunciationDictionaryRuleSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PronunciationDictionaryRuleSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, current, err := currentPronunciationDictionaryRules(c, data.DictionaryID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading pronunciation dictionary rules", err.Error())
+		return
+	}
+	if len(current) == 0 {
+		return
+	}
+
+	if _, err := c.RemovePronunciationDictionaryRules(data.DictionaryID.ValueString(), ruleStrings(current)); err != nil {
+		resp.Diagnostics.AddError("Error removing pronunciation dictionary rules", err.Error())
+		return
+	}
+}
+
+func (r *PronunciationDictionaryRuleSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughWorkspaceID(ctx, path.Root("dictionary_id"), req, resp)
+}
+
+// currentPronunciationDictionaryRules downloads the latest version of a
+// dictionary and returns the rules it contains.
+func currentPronunciationDictionaryRules(c *client.Client, dictionaryID string) (*models.PronunciationDictionary, []models.PronunciationRule, error) {
+	dict, err := c.GetPronunciationDictionary(dictionaryID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := c.DownloadPronunciationDictionary(dictionaryID, dict.LatestVersionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lex, err := pls.Decode(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dict, lex.Rules, nil
+}
+
+// syncPronunciationDictionaryRules brings the dictionary in line with the
+// planned rules and records the resulting version.
+func syncPronunciationDictionaryRules(c *client.Client, data *PronunciationDictionaryRuleSetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	dictionaryID := data.DictionaryID.ValueString()
+	desired := pronunciationRulesFromModels(data.Rules)
+
+	dict, current, err := currentPronunciationDictionaryRules(c, dictionaryID)
+	if err != nil {
+		diags.AddError("Error reading pronunciation dictionary rules", err.Error())
+		return diags
+	}
+
+	versionID := dict.LatestVersionID
+	add, remove := diffPronunciationRules(current, desired)
+
+	if len(remove) > 0 {
+		result, err := c.RemovePronunciationDictionaryRules(dictionaryID, remove)
+		if err != nil {
+			diags.AddError("Error removing pronunciation dictionary rules", err.Error())
+			return diags
+		}
+		versionID = result.VersionID
+	}
+
+	if len(add) > 0 {
+		result, err := c.AddPronunciationDictionaryRules(dictionaryID, add)
+		if err != nil {
+			diags.AddError("Error adding pronunciation dictionary rules", err.Error())
+			return diags
+		}
+		versionID = result.VersionID
+	}
+
+	data.VersionID = types.StringValue(versionID)
+	data.LatestVersionID = types.StringValue(versionID)
+
+	return diags
+}
+
+// pronunciationRulesFromConfig reads the configured rule set, reporting false
+// when it is absent or not yet known.
+func pronunciationRulesFromConfig(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) ([]RuleModel, bool) {
+	var set types.Set
+	diags.Append(config.GetAttribute(ctx, path.Root("rules"), &set)...)
+	if diags.HasError() || set.IsNull() || set.IsUnknown() {
+		return nil, false
+	}
+	for _, element := range set.Elements() {
+		if element.IsUnknown() {
+			return nil, false
+		}
+	}
+
+	var rules []RuleModel
+	diags.Append(set.ElementsAs(ctx, &rules, false)...)
+	return rules, !diags.HasError()
+}
+
+// validatePronunciationRules checks each fully known rule against the PLS
+// rules and rejects strings that are replaced by more than one rule.
+func validatePronunciationRules(rules []RuleModel, diags *diag.Diagnostics) {
+	seen := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		if rule.Type.IsUnknown() || rule.StringToReplace.IsUnknown() || rule.Alias.IsUnknown() || rule.Phoneme.IsUnknown() || rule.Alphabet.IsUnknown() {
+			continue
+		}
+
+		desired := pronunciationRulesFromModels([]RuleModel{rule})[0]
+		if err := pls.ValidateRule(desired); err != nil {
+			diags.AddAttributeError(path.Root("rules"), "Invalid Pronunciation Rule", err.Error())
+			continue
+		}
+		if seen[desired.StringToReplace] {
+			diags.AddAttributeError(
+				path.Root("rules"),
+				"Invalid Configuration",
+				fmt.Sprintf("string_to_replace %q appears in more than one rule.", desired.StringToReplace),
+			)
+		}
+		seen[desired.StringToReplace] = true
+	}
+}
+
+// diffPronunciationRules returns the rules to add and the rule strings to
+// remove to turn current into desired. Changed rules are removed and re-added.
+func diffPronunciationRules(current, desired []models.PronunciationRule) ([]models.PronunciationRule, []string) {
+	existing := make(map[string]models.PronunciationRule, len(current))
+	for _, rule := range current {
+		existing[rule.StringToReplace] = normalizePronunciationRule(rule)
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	var add []models.PronunciationRule
+	var remove []string
+	for _, rule := range desired {
+		rule = normalizePronunciationRule(rule)
+		wanted[rule.StringToReplace] = true
+
+		old, ok := existing[rule.StringToReplace]
+		if ok && old == rule {
+			continue
+		}
+		if ok {
+			remove = append(remove, rule.StringToReplace)
+		}
+		add = append(add, rule)
+	}
+
+	for key := range existing {
+		if !wanted[key] {
+			remove = append(remove, key)
+		}
+	}
+	sort.Strings(remove)
+
+	return add, remove
+}
+
+// normalizePronunciationRule clears the fields that do not apply to the rule
+// type so that rules compare equal regardless of stray attributes.
+func normalizePronunciationRule(rule models.PronunciationRule) models.PronunciationRule {
+	switch rule.Type {
+	case pls.RuleTypeAlias:
+		rule.Phoneme = ""
+		rule.Alphabet = ""
+	case pls.RuleTypePhoneme:
+		rule.Alias = ""
+	}
+	return rule
+}
+
+func pronunciationRulesFromModels(rules []RuleModel) []models.PronunciationRule {
+	result := make([]models.PronunciationRule, len(rules))
+	for i, rule := range rules {
+		result[i] = models.PronunciationRule{
+			Type:            rule.Type.ValueString(),
+			StringToReplace: rule.StringToReplace.ValueString(),
+			Alias:           rule.Alias.ValueString(),
+			Phoneme:         rule.Phoneme.ValueString(),
+			Alphabet:        rule.Alphabet.ValueString(),
+		}
+	}
+	return result
+}
+
+func ruleStrings(rules []models.PronunciationRule) []string {
+	result := make([]string, len(rules))
+	for i, rule := range rules {
+		result[i] = rule.StringToReplace
+	}
+	return result
+}
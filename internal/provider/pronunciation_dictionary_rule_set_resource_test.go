@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+type testPronunciationDictionary struct {
+	mu      sync.Mutex
+	version int
+	rules   map[string]models.PronunciationRule
+}
+
+func (d *testPronunciationDictionary) versionID() string {
+	return fmt.Sprintf("version-%d", d.version)
+}
+
+func (d *testPronunciationDictionary) pls() string {
+	keys := make([]string, 0, len(d.rules))
+	for key := range d.rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	b.WriteString(`<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US">` + "\n")
+	for _, key := range keys {
+		rule := d.rules[key]
+		b.WriteString("  <lexeme><grapheme>" + rule.StringToReplace + "</grapheme>")
+		if rule.Type == "alias" {
+			b.WriteString("<alias>" + rule.Alias + "</alias>")
+		} else {
+			b.WriteString(`<phoneme alphabet="` + rule.Alphabet + `">` + rule.Phoneme + "</phoneme>")
+		}
+		b.WriteString("</lexeme>\n")
+	}
+	b.WriteString("</lexicon>\n")
+	return b.String()
+}
+
+func (d *testPronunciationDictionary) writeVersion(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(models.PronunciationDictionaryRulesResponse{
+		ID:              "dict-123",
+		VersionID:       d.versionID(),
+		VersionRulesNum: len(d.rules),
+	})
+}
+
+func (d *testPronunciationDictionary) routes() []testRoute {
+	routes := []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/pronunciation-dictionaries/dict-123",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				d.mu.Lock()
+				defer d.mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"id":"dict-123","name":"Test Dict","latest_version_id":%q,"latest_version_rules_num":%d}`, d.versionID(), len(d.rules))
+			},
+		},
+		{
+			Method: httpMethodPost,
+			Path:   "/pronunciation-dictionaries/dict-123/add-rules",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Rules []models.PronunciationRule `json:"rules"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+
+				d.mu.Lock()
+				defer d.mu.Unlock()
+				for _, rule := range body.Rules {
+					d.rules[rule.StringToReplace] = rule
+				}
+				d.version++
+				d.writeVersion(w)
+			},
+		},
+		{
+			Method: httpMethodPost,
+			Path:   "/pronunciation-dictionaries/dict-123/remove-rules",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					RuleStrings []string `json:"rule_strings"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+
+				d.mu.Lock()
+				defer d.mu.Unlock()
+				for _, key := range body.RuleStrings {
+					delete(d.rules, key)
+				}
+				d.version++
+				d.writeVersion(w)
+			},
+		},
+	}
+
+	for version := 1; version <= 10; version++ {
+		routes = append(routes, testRoute{
+			Method: httpMethodGet,
+			Path:   fmt.Sprintf("/pronunciation-dictionaries/dict-123/version-%d/download", version),
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				d.mu.Lock()
+				defer d.mu.Unlock()
+				w.Header().Set("Content-Type", "text/plain")
+				_, _ = w.Write([]byte(d.pls()))
+			},
+		})
+	}
+
+	return routes
+}
+
+func TestAccPronunciationDictionaryRuleSetResource(t *testing.T) {
+	dict := &testPronunciationDictionary{
+		version: 1,
+		rules: map[string]models.PronunciationRule{
+			"CA": {Type: "alias", StringToReplace: "CA", Alias: "California"},
+		},
+	}
+
+	server := newTestServer(t, dict.routes())
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_pronunciation_dictionary_rule_set" "rules" {
+  dictionary_id = "dict-123"
+  rules = [
+    {
+      type              = "alias"
+      string_to_replace = "NY"
+      alias             = "New York"
+    },
+    {
+      type              = "phoneme"
+      string_to_replace = "tomato"
+      phoneme           = "təˈmeɪtoʊ"
+      alphabet          = "ipa"
+    }
+  ]
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary_rule_set.rules", "id", "dict-123"),
+					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary_rule_set.rules", "rules.#", "2"),
+					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary_rule_set.rules", "version_id", "version-3"),
+					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary_rule_set.rules", "latest_version_id", "version-3"),
+					func(*terraform.State) error {
+						dict.mu.Lock()
+						defer dict.mu.Unlock()
+						if _, ok := dict.rules["CA"]; ok {
+							return fmt.Errorf("expected unmanaged rule CA to be removed")
+						}
+						if len(dict.rules) != 2 {
+							return fmt.Errorf("expected 2 rules in dictionary, got %d", len(dict.rules))
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_pronunciation_dictionary_rule_set" "rules" {
+  dictionary_id = "dict-123"
+  rules = [
+    {
+      type              = "alias"
+      string_to_replace = "NY"
+      alias             = "New York City"
+    }
+  ]
+}
+
+data "elevenlabs_pronunciation_lexicon" "current" {
+  dictionary_id = "dict-123"
+  depends_on    = [elevenlabs_pronunciation_dictionary_rule_set.rules]
+}
+
+data "elevenlabs_pronunciation_dictionary_version_details" "pinned" {
+  dictionary_id = "dict-123"
+  version_ids   = ["version-3", elevenlabs_pronunciation_dictionary_rule_set.rules.version_id]
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_lexicon.current", "language", "en-US"),
+					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_lexicon.current", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_lexicon.current", "rules.0.grapheme", "NY"),
+					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_lexicon.current", "rules.0.alias", "New York City"),
+					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary_rule_set.rules", "rules.#", "1"),
+					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary_rule_set.rules", "rules.0.alias", "New York City"),
+					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary_rule_set.rules", "version_id", "version-5"),
+					resource.TestCheckResourceAttr("elevenlabs_pronunciation_dictionary_rule_set.rules", "latest_version_id", "version-5"),
+					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_dictionary_version_details.pinned", "latest_version_id", "version-5"),
+					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_dictionary_version_details.pinned", "latest_version_rules_num", "1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_dictionary_version_details.pinned", "versions.#", "2"),
+					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_dictionary_version_details.pinned", "versions.0.version_id", "version-3"),
+					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_dictionary_version_details.pinned", "versions.0.latest", "false"),
+					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_dictionary_version_details.pinned", "versions.1.version_id", "version-5"),
+					resource.TestCheckResourceAttr("data.elevenlabs_pronunciation_dictionary_version_details.pinned", "versions.1.latest", "true"),
+				),
+			},
+			{
+				ResourceName:            "elevenlabs_pronunciation_dictionary_rule_set.rules",
+				ImportState:             true,
+				ImportStateId:           "dict-123",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"workspace"},
+			},
+		},
+	})
+}
+
+func TestDiffPronunciationRules(t *testing.T) {
+	current := []models.PronunciationRule{
+		{Type: "alias", StringToReplace: "CA", Alias: "California"},
+		{Type: "alias", StringToReplace: "NY", Alias: "New York"},
+		{Type: "phoneme", StringToReplace: "tomato", Phoneme: "təˈmeɪtoʊ", Alphabet: "ipa"},
+	}
+	desired := []models.PronunciationRule{
+		{Type: "alias", StringToReplace: "NY", Alias: "New York City"},
+		{Type: "phoneme", StringToReplace: "tomato", Phoneme: "təˈmeɪtoʊ", Alphabet: "ipa"},
+		{Type: "alias", StringToReplace: "TX", Alias: "Texas", Alphabet: "ipa"},
+	}
+
+	add, remove := diffPronunciationRules(current, desired)
+
+	if got := strings.Join(remove, ","); got != "CA,NY" {
+		t.Errorf("Expected removals 'CA,NY', got '%s'", got)
+	}
+	if len(add) != 2 {
+		t.Fatalf("Expected 2 additions, got %d: %+v", len(add), add)
+	}
+	if add[0].StringToReplace != "NY" || add[0].Alias != "New York City" {
+		t.Errorf("Expected changed NY rule to be re-added, got %+v", add[0])
+	}
+	if add[1].StringToReplace != "TX" || add[1].Alphabet != "" {
+		t.Errorf("Expected normalized TX alias rule, got %+v", add[1])
+	}
+
+	add, remove = diffPronunciationRules(desired, desired)
+	if len(add) != 0 || len(remove) != 0 {
+		t.Errorf("Expected no changes for identical rule sets, got add=%+v remove=%v", add, remove)
+	}
+}
+
+func TestPronunciationDictionaryRuleSetResource_ValidateConfig(t *testing.T) {
+	ruleType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":              tftypes.String,
+		"string_to_replace": tftypes.String,
+		"alias":             tftypes.String,
+		"phoneme":           tftypes.String,
+		"alphabet":          tftypes.String,
+	}}
+	rule := func(kind, str, alias, phoneme, alphabet interface{}) tftypes.Value {
+		value := func(v interface{}) tftypes.Value {
+			if v == "" {
+				v = nil
+			}
+			return tftypes.NewValue(tftypes.String, v)
+		}
+		return tftypes.NewValue(ruleType, map[string]tftypes.Value{
+			"type":              value(kind),
+			"string_to_replace": value(str),
+			"alias":             value(alias),
+			"phoneme":           value(phoneme),
+			"alphabet":          value(alphabet),
+		})
+	}
+	rules := func(elements ...tftypes.Value) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"dictionary_id": tftypes.NewValue(tftypes.String, "dict-123"),
+			"rules":         tftypes.NewValue(tftypes.Set{ElementType: ruleType}, elements),
+		}
+	}
+
+	tests := map[string]struct {
+		values    map[string]tftypes.Value
+		wantError bool
+	}{
+		"valid": {rules(
+			rule("alias", "CA", "California", "", ""),
+			rule("phoneme", "tomato", "", "təˈmeɪtoʊ", "ipa"),
+		), false},
+		"alias without alias": {rules(rule("alias", "CA", "", "", "")), true},
+		"bad cmu phoneme":     {rules(rule("phoneme", "tomato", "", "T AH0 M EY1 T OW0 X", "cmu-arpabet")), true},
+		"duplicate string":    {rules(rule("alias", "CA", "California", "", ""), rule("alias", "CA", "Canada", "", "")), true},
+		"unknown alias":       {rules(rule("alias", "CA", tftypes.UnknownValue, "", "")), false},
+		"unknown rule":        {rules(tftypes.NewValue(ruleType, tftypes.UnknownValue)), false},
+	}
+
+	r := NewPronunciationDictionaryRuleSetResource()
+	for name, tt := range tests {
+		diags := validateResourceConfig(t, r, tt.values)
+		if diags.HasError() != tt.wantError {
+			t.Errorf("%s: expected error %t, got %v", name, tt.wantError, diags)
+		}
+	}
+}
@@ -28,6 +28,8 @@ type PronunciationDictionaryRulesResourceModel struct {
 	DictionaryID types.String `tfsdk:"dictionary_id"`
 	Rules        []RuleModel  `tfsdk:"rules"`
 	Action       types.String `tfsdk:"action"`
+	VersionID    types.String `tfsdk:"version_id"`
+	Workspace    types.String `tfsdk:"workspace"`
 }
 
 func (r *PronunciationDictionaryRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,6 +39,7 @@ func (r *PronunciationDictionaryRulesResource) Metadata(ctx context.Context, req
 func (r *PronunciationDictionaryRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Resource for managing pronunciation dictionary rules in ElevenLabs. Allows adding or removing rules from an existing dictionary.",
+		DeprecationMessage:  "Use elevenlabs_pronunciation_dictionary_rule_set, which manages the complete rule set of a dictionary and detects drift.",
 		Attributes: map[string]schema.Attribute{
 			"dictionary_id": schema.StringAttribute{
 				Required:            true,
@@ -69,6 +72,11 @@ func (r *PronunciationDictionaryRulesResource) Schema(ctx context.Context, req r
 				Required:            true,
 				MarkdownDescription: "Action to perform: `add` or `remove`.",
 			},
+			"version_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The dictionary version produced by the rule change.",
+			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -99,21 +107,22 @@ func (r *PronunciationDictionaryRulesResource) Create(ctx context.Context, req r
 		return
 	}
 
-	rules := make([]models.PronunciationRule, 0, len(data.Rules))
-	for _, rule := range data.Rules {
-		rules = append(rules, models.PronunciationRule{
-			StringToReplace: rule.StringToReplace.ValueString(),
-			Type:            rule.Type.ValueString(),
-		})
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	rules := pronunciationRulesFromModels(data.Rules)
+
+	var result *models.PronunciationDictionaryRulesResponse
 	var err error
 	action := data.Action.ValueString()
 	switch action {
 	case "add":
-		err = r.client.AddPronunciationDictionaryRules(data.DictionaryID.ValueString(), rules)
+		result, err = c.AddPronunciationDictionaryRules(data.DictionaryID.ValueString(), rules)
 	case "remove":
-		err = r.client.RemovePronunciationDictionaryRules(data.DictionaryID.ValueString(), rules)
+		result, err = c.RemovePronunciationDictionaryRules(data.DictionaryID.ValueString(), ruleStrings(rules))
 	default:
 		resp.Diagnostics.AddError("Invalid Action", "Action must be 'add' or 'remove'.")
 		return
@@ -124,6 +133,8 @@ func (r *PronunciationDictionaryRulesResource) Create(ctx context.Context, req r
 		return
 	}
 
+	data.VersionID = types.StringValue(result.VersionID)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -136,24 +147,22 @@ func (r *PronunciationDictionaryRulesResource) Update(ctx context.Context, req r
 		return
 	}
 
-	rules := make([]models.PronunciationRule, len(data.Rules))
-	for i, rule := range data.Rules {
-		rules[i] = models.PronunciationRule{
-			Type:            rule.Type.ValueString(),
-			StringToReplace: rule.StringToReplace.ValueString(),
-			Alias:           rule.Alias.ValueString(),
-			Phoneme:         rule.Phoneme.ValueString(),
-			Alphabet:        rule.Alphabet.ValueString(),
-		}
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	rules := pronunciationRulesFromModels(data.Rules)
+
+	var result *models.PronunciationDictionaryRulesResponse
 	var err error
 	action := data.Action.ValueString()
 	switch action {
 	case "add":
-		err = r.client.AddPronunciationDictionaryRules(data.DictionaryID.ValueString(), rules)
+		result, err = c.AddPronunciationDictionaryRules(data.DictionaryID.ValueString(), rules)
 	case "remove":
-		err = r.client.RemovePronunciationDictionaryRules(data.DictionaryID.ValueString(), rules)
+		result, err = c.RemovePronunciationDictionaryRules(data.DictionaryID.ValueString(), ruleStrings(rules))
 	default:
 		resp.Diagnostics.AddError("Invalid Action", "Action must be 'add' or 'remove'.")
 		return
@@ -164,6 +173,8 @@ func (r *PronunciationDictionaryRulesResource) Update(ctx context.Context, req r
 		return
 	}
 
+	data.VersionID = types.StringValue(result.VersionID)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -32,6 +32,7 @@ type PronunciationDictionaryUpdateResourceModel struct {
 	Name      types.String `tfsdk:"name"`
 	Archived  types.Bool   `tfsdk:"archived"`
 	VersionID types.String `tfsdk:"version_id"`
+	Workspace types.String `tfsdk:"workspace"`
 }
 
 func (r *PronunciationDictionaryUpdateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,6 +61,7 @@ func (r *PronunciationDictionaryUpdateResource) Schema(ctx context.Context, req
 				Computed:            true,
 				MarkdownDescription: "The version ID of the pronunciation dictionary after update.",
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -96,8 +98,14 @@ func (r *PronunciationDictionaryUpdateResource) Read(ctx context.Context, req re
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Read the current state to verify the resource still exists
-	dict, err := r.client.GetPronunciationDictionary(data.ID.ValueString())
+	dict, err := c.GetPronunciationDictionary(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading pronunciation dictionary", err.Error())
 		return
@@ -116,6 +124,12 @@ func (r *PronunciationDictionaryUpdateResource) Update(ctx context.Context, req
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, plan.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state PronunciationDictionaryUpdateResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -135,14 +149,14 @@ func (r *PronunciationDictionaryUpdateResource) Update(ctx context.Context, req
 		archived = &archivedValue
 	}
 
-	err := r.client.UpdatePronunciationDictionary(plan.ID.ValueString(), name, archived)
+	err := c.UpdatePronunciationDictionary(plan.ID.ValueString(), name, archived)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating pronunciation dictionary", err.Error())
 		return
 	}
 
 	// Get the updated dictionary to get the new version ID
-	dict, err := r.client.GetPronunciationDictionary(plan.ID.ValueString())
+	dict, err := c.GetPronunciationDictionary(plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading updated pronunciation dictionary", err.Error())
 		return
@@ -159,5 +173,5 @@ func (r *PronunciationDictionaryUpdateResource) Delete(ctx context.Context, req
 }
 
 func (r *PronunciationDictionaryUpdateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
 }
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/pls"
+)
+
+var (
+	_ datasource.DataSource              = &PronunciationDictionaryVersionDetailsDataSource{}
+	_ datasource.DataSourceWithConfigure = &PronunciationDictionaryVersionDetailsDataSource{}
+)
+
+func NewPronunciationDictionaryVersionDetailsDataSource() datasource.DataSource {
+	return &PronunciationDictionaryVersionDetailsDataSource{}
+}
+
+type PronunciationDictionaryVersionDetailsDataSource struct {
+	client *client.Client
+}
+
+type PronunciationDictionaryVersionDetailsDataSourceModel struct {
+	DictionaryID          types.String                            `tfsdk:"dictionary_id"`
+	VersionIDs            []types.String                          `tfsdk:"version_ids"`
+	LatestVersionID       types.String                            `tfsdk:"latest_version_id"`
+	LatestVersionRulesNum types.Int64                             `tfsdk:"latest_version_rules_num"`
+	Versions              []PronunciationDictionaryVersionDetails `tfsdk:"versions"`
+}
+
+type PronunciationDictionaryVersionDetails struct {
+	VersionID types.String `tfsdk:"version_id"`
+	RulesNum  types.Int64  `tfsdk:"rules_num"`
+	Latest    types.Bool   `tfsdk:"latest"`
+}
+
+func (d *PronunciationDictionaryVersionDetailsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pronunciation_dictionary_version_details"
+}
+
+func (d *PronunciationDictionaryVersionDetailsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the given versions of an ElevenLabs pronunciation dictionary together with its latest version. " +
+			"This is not a version history: the API does not enumerate past versions, so `versions` contains only the versions listed in `version_ids` and the latest version, " +
+			"each verified by downloading it. Use it to check that pinned versions still exist.",
+		Attributes: map[string]schema.Attribute{
+			"dictionary_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the pronunciation dictionary.",
+			},
+			"version_ids": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Previously recorded version IDs, such as `version_id` outputs of rule resources, to include in `versions`.",
+			},
+			"latest_version_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the latest version.",
+			},
+			"latest_version_rules_num": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of rules in the latest version.",
+			},
+			"versions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The requested versions followed by the latest version, without duplicates.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"rules_num": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of rules in the version.",
+						},
+						"latest": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether this is the latest version.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PronunciationDictionaryVersionDetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PronunciationDictionaryVersionDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PronunciationDictionaryVersionDetailsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dictionaryID := data.DictionaryID.ValueString()
+	dict, err := d.client.GetPronunciationDictionary(dictionaryID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting pronunciation dictionary", err.Error())
+		return
+	}
+
+	versionIDs := make([]string, 0, len(data.VersionIDs)+1)
+	seen := make(map[string]bool, len(data.VersionIDs)+1)
+	for _, id := range data.VersionIDs {
+		if id.IsNull() || seen[id.ValueString()] {
+			continue
+		}
+		seen[id.ValueString()] = true
+		versionIDs = append(versionIDs, id.ValueString())
+	}
+	if !seen[dict.LatestVersionID] {
+		versionIDs = append(versionIDs, dict.LatestVersionID)
+	}
+
+	data.Versions = make([]PronunciationDictionaryVersionDetails, 0, len(versionIDs))
+	for _, versionID := range versionIDs {
+		content, err := d.client.DownloadPronunciationDictionary(dictionaryID, versionID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error downloading pronunciation dictionary version %q", versionID), err.Error())
+			return
+		}
+
+		lex, err := pls.Decode(content)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error parsing pronunciation dictionary version %q", versionID), err.Error())
+			return
+		}
+
+		data.Versions = append(data.Versions, PronunciationDictionaryVersionDetails{
+			VersionID: types.StringValue(versionID),
+			RulesNum:  types.Int64Value(int64(len(lex.Rules))),
+			Latest:    types.BoolValue(versionID == dict.LatestVersionID),
+		})
+	}
+
+	data.LatestVersionID = types.StringValue(dict.LatestVersionID)
+	data.LatestVersionRulesNum = types.Int64Value(int64(dict.LatestVersionRulesNum))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
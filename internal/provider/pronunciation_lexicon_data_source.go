@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/pls"
+)
+
+var (
+	_ datasource.DataSource              = &PronunciationLexiconDataSource{}
+	_ datasource.DataSourceWithConfigure = &PronunciationLexiconDataSource{}
+)
+
+func NewPronunciationLexiconDataSource() datasource.DataSource {
+	return &PronunciationLexiconDataSource{}
+}
+
+type PronunciationLexiconDataSource struct {
+	client *client.Client
+}
+
+type PronunciationLexiconDataSourceModel struct {
+	DictionaryID types.String       `tfsdk:"dictionary_id"`
+	VersionID    types.String       `tfsdk:"version_id"`
+	Language     types.String       `tfsdk:"language"`
+	Alphabet     types.String       `tfsdk:"alphabet"`
+	Content      types.String       `tfsdk:"content"`
+	Rules        []LexiconRuleModel `tfsdk:"rules"`
+}
+
+type LexiconRuleModel struct {
+	Type     types.String `tfsdk:"type"`
+	Grapheme types.String `tfsdk:"grapheme"`
+	Alias    types.String `tfsdk:"alias"`
+	Phoneme  types.String `tfsdk:"phoneme"`
+	Alphabet types.String `tfsdk:"alphabet"`
+}
+
+func (d *PronunciationLexiconDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pronunciation_lexicon"
+}
+
+func (d *PronunciationLexiconDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source that downloads a pronunciation dictionary version and exposes its parsed PLS rules.",
+		Attributes: map[string]schema.Attribute{
+			"dictionary_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the pronunciation dictionary.",
+			},
+			"version_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The version ID to read. Defaults to the latest version.",
+			},
+			"language": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The lexicon language (`xml:lang`).",
+			},
+			"alphabet": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The default phonetic alphabet of the lexicon.",
+			},
+			"content": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The raw PLS document.",
+			},
+			"rules": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Rules parsed from the lexicon, one per grapheme.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of rule: `alias` or `phoneme`.",
+						},
+						"grapheme": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The text that is replaced.",
+						},
+						"alias": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Replacement text for `alias` rules.",
+						},
+						"phoneme": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Pronunciation for `phoneme` rules.",
+						},
+						"alphabet": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Phonetic alphabet for `phoneme` rules.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PronunciationLexiconDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PronunciationLexiconDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PronunciationLexiconDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versionID := data.VersionID.ValueString()
+	if versionID == "" {
+		dict, err := d.client.GetPronunciationDictionary(data.DictionaryID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting pronunciation dictionary", err.Error())
+			return
+		}
+		versionID = dict.LatestVersionID
+	}
+
+	content, err := d.client.DownloadPronunciationDictionary(data.DictionaryID.ValueString(), versionID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error downloading pronunciation dictionary", err.Error())
+		return
+	}
+
+	lex, err := pls.Decode(content)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing pronunciation dictionary", err.Error())
+		return
+	}
+
+	data.VersionID = types.StringValue(versionID)
+	data.Language = types.StringValue(lex.Language)
+	data.Alphabet = optionalStringValue(lex.Alphabet)
+	data.Content = types.StringValue(string(content))
+	data.Rules = make([]LexiconRuleModel, len(lex.Rules))
+	for i, rule := range lex.Rules {
+		data.Rules[i] = LexiconRuleModel{
+			Type:     types.StringValue(rule.Type),
+			Grapheme: types.StringValue(rule.StringToReplace),
+			Alias:    optionalStringValue(rule.Alias),
+			Phoneme:  optionalStringValue(rule.Phoneme),
+			Alphabet: optionalStringValue(rule.Alphabet),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
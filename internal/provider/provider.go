@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,6 +15,8 @@ import (
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
 )
 
+var _ provider.ProviderWithEphemeralResources = &ElevenLabsProvider{}
+
 // ElevenLabsProvider defines the provider implementation.
 type ElevenLabsProvider struct {
 	version string
@@ -19,8 +24,20 @@ type ElevenLabsProvider struct {
 
 // ElevenLabsProviderModel describes the provider data model.
 type ElevenLabsProviderModel struct {
-	ApiKey  types.String `tfsdk:"api_key"`
-	BaseURL types.String `tfsdk:"base_url"`
+	ApiKey                types.String `tfsdk:"api_key"`
+	ApiKeyFile            types.String `tfsdk:"api_key_file"`
+	Profile               types.String `tfsdk:"profile"`
+	SharedCredentialsFile types.String `tfsdk:"shared_credentials_file"`
+	ValidateCredentials   types.Bool   `tfsdk:"validate_credentials"`
+	BaseURL               types.String `tfsdk:"base_url"`
+	Workspaces            types.Map    `tfsdk:"workspaces"`
+}
+
+// ElevenLabsWorkspaceModel describes the credentials of a named workspace.
+type ElevenLabsWorkspaceModel struct {
+	ApiKey     types.String `tfsdk:"api_key"`
+	ApiKeyFile types.String `tfsdk:"api_key_file"`
+	Profile    types.String `tfsdk:"profile"`
 }
 
 func (p *ElevenLabsProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -36,10 +53,50 @@ func (p *ElevenLabsProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the ElevenLabs API Key. May also be provided via ELEVENLABS_API_KEY_FILE environment variable.",
+				Optional:            true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Profile in the shared credentials file to read the API Key from. May also be provided via ELEVENLABS_PROFILE environment variable. " +
+					"When no other credentials are configured the `default` profile is used if present.",
+				Optional: true,
+			},
+			"shared_credentials_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the shared credentials file. Defaults to `~/.elevenlabs/credentials`. May also be provided via ELEVENLABS_SHARED_CREDENTIALS_FILE environment variable.",
+				Optional:            true,
+			},
+			"validate_credentials": schema.BoolAttribute{
+				MarkdownDescription: "Verify every configured API Key against the `/v1/user` endpoint while configuring the provider, so invalid keys are reported before any resource is touched. " +
+					"This only checks that the key is accepted; the API does not report a key's permissions, so a key missing a permission still fails on the first call that needs it.",
+				Optional: true,
+			},
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "ElevenLabs API Base URL. Used for testing.",
 				Optional:            true,
 			},
+			"workspaces": schema.MapNestedAttribute{
+				MarkdownDescription: "Additional ElevenLabs workspaces keyed by name. Resources select one of these with their `workspace` attribute; resources without it use `api_key`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"api_key": schema.StringAttribute{
+							MarkdownDescription: "API key for the workspace.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"api_key_file": schema.StringAttribute{
+							MarkdownDescription: "Path to a file containing the API key for the workspace.",
+							Optional:            true,
+						},
+						"profile": schema.StringAttribute{
+							MarkdownDescription: "Profile in the shared credentials file to read the API key from. " +
+								"Defaults to the ELEVENLABS_API_KEY_<NAME> environment variable, then to a profile named after the workspace.",
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -53,17 +110,37 @@ func (p *ElevenLabsProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	apiKey := os.Getenv("ELEVENLABS_API_KEY")
+	credentialsFile := os.Getenv("ELEVENLABS_SHARED_CREDENTIALS_FILE")
+	if !data.SharedCredentialsFile.IsNull() {
+		credentialsFile = data.SharedCredentialsFile.ValueString()
+	}
+	if credentialsFile == "" {
+		credentialsFile = defaultSharedCredentialsFile
+	}
 
-	if !data.ApiKey.IsNull() {
-		apiKey = data.ApiKey.ValueString()
+	apiKey, err := resolveAPIKey(apiKeySource{
+		APIKey:          data.ApiKey,
+		APIKeyFile:      data.ApiKeyFile,
+		Profile:         data.Profile,
+		EnvVar:          "ELEVENLABS_API_KEY",
+		EnvFileVar:      "ELEVENLABS_API_KEY_FILE",
+		EnvProfile:      "ELEVENLABS_PROFILE",
+		FallbackProfile: "default",
+	}, credentialsFile)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read ElevenLabs Credentials",
+			"The provider cannot read the ElevenLabs API key: "+err.Error(),
+		)
+		return
 	}
 
 	if apiKey == "" {
 		resp.Diagnostics.AddError(
 			"Missing ElevenLabs API Key",
 			"The provider cannot create the ElevenLabs API client as there is no API key. "+
-				"Please set the api_key attribute in the provider block or use the ELEVENLABS_API_KEY environment variable.",
+				"Please set the api_key, api_key_file or profile attribute in the provider block, "+
+				"use the ELEVENLABS_API_KEY environment variable, or add a default profile to "+credentialsFile+".",
 		)
 	}
 
@@ -78,8 +155,76 @@ func (p *ElevenLabsProvider) Configure(ctx context.Context, req provider.Configu
 
 	c := client.NewClient(apiKey, baseURL)
 
+	if !data.Workspaces.IsNull() && !data.Workspaces.IsUnknown() {
+		workspaces := make(map[string]ElevenLabsWorkspaceModel)
+		resp.Diagnostics.Append(data.Workspaces.ElementsAs(ctx, &workspaces, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for name, workspace := range workspaces {
+			workspacePath := path.Root("workspaces").AtMapKey(name)
+
+			workspaceKey, err := resolveAPIKey(apiKeySource{
+				APIKey:          workspace.ApiKey,
+				APIKeyFile:      workspace.ApiKeyFile,
+				Profile:         workspace.Profile,
+				EnvVar:          workspaceEnvVar(name),
+				FallbackProfile: name,
+			}, credentialsFile)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					workspacePath,
+					"Unable to Read ElevenLabs Credentials",
+					fmt.Sprintf("The provider cannot read the API key for workspace %q: %s", name, err),
+				)
+				continue
+			}
+
+			if workspaceKey == "" {
+				resp.Diagnostics.AddAttributeError(
+					workspacePath.AtName("api_key"),
+					"Missing ElevenLabs API Key",
+					fmt.Sprintf("The provider cannot create the ElevenLabs API client for workspace %q as there is no API key. "+
+						"Please set api_key, api_key_file or profile for the workspace, or use the %s environment variable.", name, workspaceEnvVar(name)),
+				)
+				continue
+			}
+			c.AddWorkspace(name, workspaceKey)
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if data.ValidateCredentials.ValueBool() {
+		for _, name := range append([]string{""}, c.WorkspaceNames()...) {
+			wc, err := c.Workspace(name)
+			if err == nil {
+				_, err = wc.GetUser()
+			}
+			if err != nil {
+				target := "the provider api_key"
+				if name != "" {
+					target = fmt.Sprintf("workspace %q", name)
+				}
+				resp.Diagnostics.AddError(
+					"Invalid ElevenLabs Credentials",
+					fmt.Sprintf("The API key for %s was rejected by the ElevenLabs API: %s\n\n"+
+						"Check that the key is valid and has not been disabled. Keys restricted by permissions need user_read to be validated.", target, err),
+				)
+			}
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
 }
 
 func (p *ElevenLabsProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -89,10 +234,12 @@ func (p *ElevenLabsProvider) Resources(ctx context.Context) []func() resource.Re
 		NewPronunciationDictionaryResource,
 		NewPronunciationDictionaryUpdateResource,
 		NewPronunciationDictionaryRulesResource,
+		NewPronunciationDictionaryRuleSetResource,
 		NewAudioNativeResource,
 		NewAudioNativeContentUpdateResource,
 		NewConvAIAgentResource,
 		NewConvAIKnowledgeBaseResource,
+		NewConvAIKnowledgeBaseDirectoryResource,
 		NewConvAIToolResource,
 		NewConvAISecretResource,
 		NewWorkspaceWebhookResource,
@@ -104,8 +251,12 @@ func (p *ElevenLabsProvider) Resources(ctx context.Context) []func() resource.Re
 		NewConvAIWhatsAppAccountResource,
 		NewWorkspaceMemberResource,
 		NewWorkspaceGroupMemberResource,
+		NewWorkspaceGroupResource,
+		NewWorkspaceInvitesResource,
 		NewConvAISettingsResource,
 		NewResourceShareResource,
+		NewResourceAccessResource,
+		NewResourceCopyResource,
 		NewConvAIAgentTestResource,
 		NewConvAIAgentTestRunnerResource,
 		NewConvAIConversationSimulatorResource,
@@ -127,11 +278,17 @@ func (p *ElevenLabsProvider) DataSources(ctx context.Context) []func() datasourc
 		NewProjectsDataSource,
 		NewPronunciationDictionariesDataSource,
 		NewPronunciationDictionaryDownloadDataSource,
+		NewPronunciationLexiconDataSource,
+		NewPronunciationDictionaryVersionDetailsDataSource,
 		NewConvAIAgentsDataSource,
 		NewConvAIAgentsFilteredDataSource,
+		NewConvAIAgentTestSummariesDataSource,
 		NewConvAILLMUsageCalculatorDataSource,
 		NewConvAIKnowledgeBasesDataSource,
+		NewConvAIKnowledgeBaseDocumentDataSource,
+		NewConvAIKnowledgeBaseUsageDataSource,
 		NewConvAIToolsDataSource,
+		NewConvAIToolDependentAgentsDataSource,
 		NewConvAIWhatsAppAccountsDataSource,
 		NewConvAIPhoneNumbersDataSource,
 		NewConvAIConversationsDataSource,
@@ -145,12 +302,22 @@ func (p *ElevenLabsProvider) DataSources(ctx context.Context) []func() datasourc
 		NewPVCVoicesDataSource,
 		NewPVCVoiceSamplesDataSource,
 		NewWorkspaceWebhooksDataSource,
+		NewWorkspaceWebhookHealthDataSource,
 		NewWorkspaceServiceAccountsDataSource,
+		NewServiceAccountKeysDataSource,
 		NewWorkspaceResourcesDataSource,
 		NewWorkspaceInvitesDataSource,
 	}
 }
 
+func (p *ElevenLabsProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewConvAISignedUrlEphemeralResource,
+		NewConvAIConversationTokenEphemeralResource,
+		NewSingleUseTokenEphemeralResource,
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &ElevenLabsProvider{
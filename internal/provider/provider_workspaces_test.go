@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProviderWorkspaces(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		// Requests for the secret must be made with the shared workspace key
+		if r.Header.Get("xi-api-key") != "shared-key" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/convai/secrets":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"secret_id": "secret-123", "name": "shared-secret"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/convai/secrets/secret-123":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"secret_id": "secret-123", "name": "shared-secret"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/convai/secrets/secret-123":
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.Error(w, "Not Found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "elevenlabs" {
+  api_key  = "default-key"
+  base_url = "%s"
+
+  workspaces = {
+    shared = {
+      api_key = "shared-key"
+    }
+  }
+}
+
+resource "elevenlabs_convai_secret" "test" {
+  workspace = "shared"
+  name      = "shared-secret"
+  value     = "secret-value"
+}
+`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_convai_secret.test", "id", "secret-123"),
+					resource.TestCheckResourceAttr("elevenlabs_convai_secret.test", "workspace", "shared"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "elevenlabs" {
+  api_key  = "default-key"
+  base_url = "%s"
+
+  workspaces = {
+    shared = {
+      api_key = "shared-key"
+    }
+  }
+}
+
+resource "elevenlabs_convai_secret" "test" {
+  workspace = "missing"
+  name      = "shared-secret"
+  value     = "secret-value"
+}
+`, server.URL),
+				ExpectError: regexp.MustCompile(`Unknown ElevenLabs workspace`),
+			},
+		},
+	})
+}
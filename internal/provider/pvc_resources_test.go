@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccPVCResources(t *testing.T) {
@@ -114,3 +118,121 @@ resource "elevenlabs_pvc_voice_sample" "sample" {
 		},
 	})
 }
+
+func TestAccPVCVoiceResourceCaptchaWriteOnly(t *testing.T) {
+	var solutions []string
+	voice := `{"voice_id":"voice-123","name":"PVC Voice","language":"en","description":"Captcha","state":"ready","verification":"pending"}`
+
+	server := newTestServer(t, []testRoute{
+		{
+			Method: http.MethodPost,
+			Path:   "/voices/pvc",
+			Body:   voice,
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/voices/pvc/voice-123",
+			Body:   voice,
+		},
+		{
+			Method: http.MethodPatch,
+			Path:   "/voices/pvc/voice-123",
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/voices/pvc/voice-123/verification/captcha",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]string
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				if body["captcha_id"] != "captcha-123" {
+					http.Error(w, "Bad Request", http.StatusBadRequest)
+					return
+				}
+				solutions = append(solutions, body["solution"])
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/voices/pvc/voice-123",
+		},
+	})
+	defer server.Close()
+
+	config := func(solution string, version int) string {
+		return fmt.Sprintf(`
+%s
+
+resource "elevenlabs_pvc_voice" "test" {
+  name                = "PVC Voice"
+  language            = "en"
+  description         = "Captcha"
+  captcha_id          = "captcha-123"
+  captcha_solution_wo = "%s"
+  captcha_version     = %d
+}
+`, testAccProviderConfig(server.URL), solution, version)
+	}
+
+	checkSolutions := func(want ...string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if fmt.Sprint(solutions) != fmt.Sprint(want) {
+				return fmt.Errorf("unexpected captcha solutions sent to the API: %v", solutions)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("first-solution", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_pvc_voice.test", "id", "voice-123"),
+					resource.TestCheckNoResourceAttr("elevenlabs_pvc_voice.test", "captcha_solution_wo"),
+					checkSolutions("first-solution"),
+				),
+			},
+			{
+				// A new solution without a new version is not submitted.
+				Config: config("second-solution", 1),
+				Check:  checkSolutions("first-solution"),
+			},
+			{
+				Config: config("second-solution", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("elevenlabs_pvc_voice.test", "captcha_solution_wo"),
+					checkSolutions("first-solution", "second-solution"),
+				),
+			},
+		},
+	})
+}
+
+func TestPVCVoiceResource_ValidateConfig(t *testing.T) {
+	r := NewPVCVoiceResource()
+	captchaID := tftypes.NewValue(tftypes.String, "captcha-123")
+	solution := tftypes.NewValue(tftypes.String, "solution")
+	version := tftypes.NewValue(tftypes.Number, 1)
+
+	tests := map[string]struct {
+		values    map[string]tftypes.Value
+		wantError bool
+	}{
+		"no captcha":               {map[string]tftypes.Value{}, false},
+		"solution with captcha_id": {map[string]tftypes.Value{"captcha_id": captchaID, "captcha_solution_wo": solution, "captcha_version": version}, false},
+		"solution without id":      {map[string]tftypes.Value{"captcha_solution_wo": solution}, true},
+		"version without solution": {map[string]tftypes.Value{"captcha_id": captchaID, "captcha_version": version}, true},
+	}
+
+	for name, tt := range tests {
+		diags := validateResourceConfig(t, r, tt.values)
+		if diags.HasError() != tt.wantError {
+			t.Errorf("%s: expected error %t, got %v", name, tt.wantError, diags)
+		}
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
@@ -16,21 +18,26 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &PVCVoiceResource{}
-	_ resource.ResourceWithConfigure   = &PVCVoiceResource{}
-	_ resource.ResourceWithImportState = &PVCVoiceResource{}
+	_ resource.Resource                   = &PVCVoiceResource{}
+	_ resource.ResourceWithConfigure      = &PVCVoiceResource{}
+	_ resource.ResourceWithImportState    = &PVCVoiceResource{}
+	_ resource.ResourceWithValidateConfig = &PVCVoiceResource{}
 )
 
 // PVCVoiceResourceModel describes the resource data model.
 type PVCVoiceResourceModel struct {
-	ID           types.String   `tfsdk:"id"`
-	Name         types.String   `tfsdk:"name"`
-	Language     types.String   `tfsdk:"language"`
-	Description  types.String   `tfsdk:"description"`
-	Labels       types.Map      `tfsdk:"labels"`
-	State        types.String   `tfsdk:"state"`
-	Verification types.String   `tfsdk:"verification"`
-	Settings     *VoiceSettings `tfsdk:"settings"`
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Language          types.String   `tfsdk:"language"`
+	Description       types.String   `tfsdk:"description"`
+	Labels            types.Map      `tfsdk:"labels"`
+	State             types.String   `tfsdk:"state"`
+	Verification      types.String   `tfsdk:"verification"`
+	Settings          *VoiceSettings `tfsdk:"settings"`
+	CaptchaID         types.String   `tfsdk:"captcha_id"`
+	CaptchaSolutionWO types.String   `tfsdk:"captcha_solution_wo"`
+	CaptchaVersion    types.Int64    `tfsdk:"captcha_version"`
+	Workspace         types.String   `tfsdk:"workspace"`
 }
 
 func NewPVCVoiceResource() resource.Resource {
@@ -103,6 +110,21 @@ func (r *PVCVoiceResource) Schema(ctx context.Context, req resource.SchemaReques
 					},
 				},
 			},
+			"captcha_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the verification captcha being answered by `captcha_solution_wo`.",
+			},
+			"captcha_solution_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Write-only captcha solution submitted to verify the PVC voice. It is never stored in state. Requires Terraform 1.11 or later.",
+			},
+			"captcha_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `captcha_solution_wo`. Increment to submit a new captcha solution.",
+			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -126,6 +148,28 @@ func (r *PVCVoiceResource) Configure(ctx context.Context, req resource.Configure
 	r.client = client
 }
 
+func (r *PVCVoiceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var captchaID, solution types.String
+	var version types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("captcha_id"), &captchaID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("captcha_solution_wo"), &solution)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("captcha_version"), &version)...)
+	if resp.Diagnostics.HasError() || solution.IsUnknown() {
+		return
+	}
+
+	if solution.IsNull() {
+		if !version.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("captcha_version"), "Invalid Configuration", "`captcha_version` only applies to `captcha_solution_wo`.")
+		}
+		return
+	}
+
+	if captchaID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("captcha_id"), "Invalid Configuration", "`captcha_id` is required with `captcha_solution_wo`.")
+	}
+}
+
 func (r *PVCVoiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data PVCVoiceResourceModel
 
@@ -135,6 +179,12 @@ func (r *PVCVoiceResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createReq := &models.CreatePVCVoiceRequest{
 		Name:        data.Name.ValueString(),
 		Language:    data.Language.ValueString(),
@@ -150,12 +200,26 @@ func (r *PVCVoiceResource) Create(ctx context.Context, req resource.CreateReques
 		createReq.Labels = labels
 	}
 
-	voice, err := r.client.CreatePVCVoice(createReq)
+	voice, err := c.CreatePVCVoice(createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create PVC voice, got error: %s", err))
 		return
 	}
 
+	submitted, diags := submitPVCVoiceCaptcha(ctx, c, req.Config, voice.VoiceID, data.CaptchaID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if submitted {
+		voice, err = c.GetPVCVoice(voice.VoiceID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read PVC voice, got error: %s", err))
+			return
+		}
+	}
+
 	data.ID = types.StringValue(voice.VoiceID)
 	data.Name = types.StringValue(voice.Name)
 	data.Language = types.StringValue(voice.Language)
@@ -195,7 +259,13 @@ func (r *PVCVoiceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	voice, err := r.client.GetPVCVoice(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	voice, err := c.GetPVCVoice(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read PVC voice, got error: %s", err))
 		return
@@ -239,6 +309,12 @@ func (r *PVCVoiceResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := &models.UpdatePVCVoiceRequest{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
@@ -253,14 +329,30 @@ func (r *PVCVoiceResource) Update(ctx context.Context, req resource.UpdateReques
 		updateReq.Labels = labels
 	}
 
-	err := r.client.UpdatePVCVoice(data.ID.ValueString(), updateReq)
+	err := c.UpdatePVCVoice(data.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update PVC voice, got error: %s", err))
 		return
 	}
 
+	var state PVCVoiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values never show up in a diff, so a new captcha solution is
+	// only submitted when its version changes.
+	if !data.CaptchaVersion.Equal(state.CaptchaVersion) {
+		_, diags := submitPVCVoiceCaptcha(ctx, c, req.Config, data.ID.ValueString(), data.CaptchaID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Read the updated voice to get current state
-	voice, err := r.client.GetPVCVoice(data.ID.ValueString())
+	voice, err := c.GetPVCVoice(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated PVC voice, got error: %s", err))
 		return
@@ -301,7 +393,13 @@ func (r *PVCVoiceResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := r.client.DeletePVCVoice(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeletePVCVoice(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete PVC voice, got error: %s", err))
 		return
@@ -309,5 +407,28 @@ func (r *PVCVoiceResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *PVCVoiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
+}
+
+// submitPVCVoiceCaptcha submits the write-only captcha solution from the
+// configuration, if one is set, and reports whether it did.
+func submitPVCVoiceCaptcha(ctx context.Context, c *client.Client, config tfsdk.Config, voiceID string, captchaID types.String) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var solution types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("captcha_solution_wo"), &solution)...)
+	if diags.HasError() || solution.IsNull() {
+		return false, diags
+	}
+
+	err := c.HandlePVCVoiceCaptcha(voiceID, &models.PVCVoiceCaptchaRequest{
+		CaptchaID: captchaID.ValueString(),
+		Solution:  solution.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to submit PVC voice captcha, got error: %s", err))
+		return false, diags
+	}
+
+	return true, diags
 }
@@ -36,6 +36,7 @@ type PVCVoiceSampleResourceModel struct {
 	Duration      types.Float64 `tfsdk:"duration"`
 	SampleRate    types.Int64   `tfsdk:"sample_rate"`
 	Channels      types.Int64   `tfsdk:"channels"`
+	Workspace     types.String  `tfsdk:"workspace"`
 }
 
 func NewPVCVoiceSampleResource() resource.Resource {
@@ -113,6 +114,7 @@ func (r *PVCVoiceSampleResource) Schema(ctx context.Context, req resource.Schema
 				Computed:            true,
 				MarkdownDescription: "The number of audio channels.",
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -145,11 +147,17 @@ func (r *PVCVoiceSampleResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq := &models.AddPVCVoiceSampleRequest{
 		FilePath: data.FilePath.ValueString(),
 	}
 
-	sample, err := r.client.AddPVCVoiceSample(data.VoiceID.ValueString(), addReq)
+	sample, err := c.AddPVCVoiceSample(data.VoiceID.ValueString(), addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add PVC voice sample, got error: %s", err))
 		return
@@ -178,8 +186,14 @@ func (r *PVCVoiceSampleResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get all samples for the voice and find the specific one
-	samplesResp, err := r.client.ListPVCVoiceSamples(data.VoiceID.ValueString())
+	samplesResp, err := c.ListPVCVoiceSamples(data.VoiceID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list PVC voice samples, got error: %s", err))
 		return
@@ -220,18 +234,24 @@ func (r *PVCVoiceSampleResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := &models.UpdatePVCVoiceSampleRequest{
 		Transcription: data.Transcription.ValueString(),
 	}
 
-	err := r.client.UpdatePVCVoiceSample(data.VoiceID.ValueString(), data.ID.ValueString(), updateReq)
+	err := c.UpdatePVCVoiceSample(data.VoiceID.ValueString(), data.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update PVC voice sample, got error: %s", err))
 		return
 	}
 
 	// Read the updated sample to get current state
-	samplesResp, err := r.client.ListPVCVoiceSamples(data.VoiceID.ValueString())
+	samplesResp, err := c.ListPVCVoiceSamples(data.VoiceID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list PVC voice samples, got error: %s", err))
 		return
@@ -267,7 +287,13 @@ func (r *PVCVoiceSampleResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	err := r.client.DeletePVCVoiceSample(data.VoiceID.ValueString(), data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeletePVCVoiceSample(data.VoiceID.ValueString(), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete PVC voice sample, got error: %s", err))
 		return
@@ -275,8 +301,9 @@ func (r *PVCVoiceSampleResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *PVCVoiceSampleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: voice_id/sample_id
-	idParts := strings.Split(req.ID, "/")
+	// Import format: [workspace/]voice_id/sample_id
+	id := importStateWorkspace(ctx, req.ID, 2, resp)
+	idParts := strings.Split(id, "/")
 	if len(idParts) != 2 {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
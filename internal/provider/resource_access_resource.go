@@ -0,0 +1,483 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+var (
+	_ resource.Resource                = &ResourceAccessResource{}
+	_ resource.ResourceWithConfigure   = &ResourceAccessResource{}
+	_ resource.ResourceWithImportState = &ResourceAccessResource{}
+)
+
+func NewResourceAccessResource() resource.Resource {
+	return &ResourceAccessResource{}
+}
+
+type ResourceAccessResource struct {
+	client *client.Client
+}
+
+type ResourceAccessResourceModel struct {
+	ID            types.String               `tfsdk:"id"`
+	ResourceID    types.String               `tfsdk:"resource_id"`
+	ResourceType  types.String               `tfsdk:"resource_type"`
+	Grants        []ResourceAccessGrantModel `tfsdk:"grants"`
+	CreatorUserID types.String               `tfsdk:"creator_user_id"`
+	Workspace     types.String               `tfsdk:"workspace"`
+}
+
+type ResourceAccessGrantModel struct {
+	Role              types.String `tfsdk:"role"`
+	Email             types.String `tfsdk:"email"`
+	GroupID           types.String `tfsdk:"group_id"`
+	WorkspaceAPIKeyID types.String `tfsdk:"workspace_api_key_id"`
+}
+
+func (r *ResourceAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_access"
+}
+
+func (r *ResourceAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages who can access a workspace resource. " +
+			"Grants that are not listed in `grants` are removed, except for the resource creator.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Type of resource: `voice`, `pronunciation_dictionary`, `convai_agents`, etc.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grants": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The complete set of grants on the resource. Each grant sets exactly one of `email`, `group_id` or `workspace_api_key_id`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Role to grant: `admin`, `editor`, `commenter` or `viewer`.",
+						},
+						"email": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Email of the user or service account.",
+						},
+						"group_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "ID of the workspace group. Use `default` for the access every workspace member has.",
+						},
+						"workspace_api_key_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "ID of the workspace API key.",
+						},
+					},
+				},
+			},
+			"creator_user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user who created the resource. The creator keeps access and is never listed in `grants`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace": workspaceAttribute(),
+		},
+	}
+}
+
+func (r *ResourceAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ResourceAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ResourceAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncGrants(c, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.ResourceType.ValueString() + ":" + data.ResourceID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ResourceAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ResourceAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := c.GetWorkspaceResource(data.ResourceID.ValueString(), data.ResourceType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource access", err.Error())
+		return
+	}
+
+	userIDs, emails, err := workspaceMemberUserIDs(c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace members", err.Error())
+		return
+	}
+
+	roles := resourcePrincipalRoles(res)
+	grants := []ResourceAccessGrantModel{}
+	seen := make(map[string]bool)
+
+	// Keep the managed grants in the form they were configured in so that
+	// only role changes and removals show up as drift.
+	for _, grant := range data.Grants {
+		shareReq, err := resourceSharePrincipal(data.ResourceType.ValueString(), grant.Email, grant.GroupID, grant.WorkspaceAPIKeyID)
+		if err != nil {
+			continue
+		}
+		principalID := resourcePrincipalID(shareReq, userIDs)
+		if principalID == "" {
+			// Service account emails cannot be matched, so the grant is kept as recorded.
+			grants = append(grants, grant)
+			continue
+		}
+		if role, ok := roles[principalID]; ok {
+			grant.Role = types.StringValue(role)
+			grants = append(grants, grant)
+			seen[principalID] = true
+		}
+	}
+
+	// Grants made outside Terraform are recorded so that the next apply removes them.
+	var unmanaged []string
+	for principalID := range roles {
+		if !seen[principalID] {
+			unmanaged = append(unmanaged, principalID)
+		}
+	}
+	sort.Strings(unmanaged)
+	principalTypes, err := resourcePrincipalTypes(c, res, unmanaged, emails)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace API keys", err.Error())
+		return
+	}
+	for _, principalID := range unmanaged {
+		principalType, ok := principalTypes[principalID]
+		if !ok {
+			addUnknownPrincipalWarning(&resp.Diagnostics, data.ResourceID.ValueString(), principalID)
+			continue
+		}
+		shareReq := resourceUnsharePrincipal(data.ResourceType.ValueString(), principalID, principalType, emails)
+		grants = append(grants, ResourceAccessGrantModel{
+			Role:              types.StringValue(roles[principalID]),
+			Email:             optionalStringValue(shareReq.UserEmail),
+			GroupID:           optionalStringValue(shareReq.GroupID),
+			WorkspaceAPIKeyID: optionalStringValue(shareReq.WorkspaceAPIKeyID),
+		})
+	}
+
+	data.Grants = grants
+	data.CreatorUserID = optionalStringValue(res.CreatorUserID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ResourceAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ResourceAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncGrants(c, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ResourceAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, grant := range data.Grants {
+		shareReq, err := resourceSharePrincipal(data.ResourceType.ValueString(), grant.Email, grant.GroupID, grant.WorkspaceAPIKeyID)
+		if err != nil {
+			continue
+		}
+		if err := c.UnshareResource(data.ResourceID.ValueString(), shareReq); err != nil {
+			resp.Diagnostics.AddError("Error unsharing resource", fmt.Sprintf("Could not remove access of %s: %s", resourceSharePrincipalID(shareReq), err))
+			return
+		}
+	}
+}
+
+func (r *ResourceAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importStateWorkspace(ctx, req.ID, 1, resp)
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: resource_type:resource_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), parts[1])...)
+}
+
+// syncGrants shares the resource with every planned grant whose role differs
+// from the current one and removes the access of every other principal.
+func (r *ResourceAccessResource) syncGrants(c *client.Client, data *ResourceAccessResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	resourceID := data.ResourceID.ValueString()
+	resourceType := data.ResourceType.ValueString()
+
+	desired := make([]*models.ShareWorkspaceResourceRequest, 0, len(data.Grants))
+	for _, grant := range data.Grants {
+		shareReq, err := resourceSharePrincipal(resourceType, grant.Email, grant.GroupID, grant.WorkspaceAPIKeyID)
+		if err != nil {
+			diags.AddAttributeError(path.Root("grants"), "Invalid Configuration", err.Error())
+			return diags
+		}
+		shareReq.Role = grant.Role.ValueString()
+		desired = append(desired, shareReq)
+	}
+
+	res, err := c.GetWorkspaceResource(resourceID, resourceType)
+	if err != nil {
+		diags.AddError("Error reading resource access", err.Error())
+		return diags
+	}
+	data.CreatorUserID = optionalStringValue(res.CreatorUserID)
+
+	userIDs, emails, err := workspaceMemberUserIDs(c)
+	if err != nil {
+		diags.AddError("Error reading workspace members", err.Error())
+		return diags
+	}
+
+	current := resourcePrincipalRoles(res)
+	share, unshare := diffResourceGrants(current, desired, userIDs)
+
+	for _, shareReq := range share {
+		if err := c.ShareResource(resourceID, shareReq); err != nil {
+			diags.AddError("Error sharing resource", fmt.Sprintf("Could not grant %s to %s: %s", shareReq.Role, resourceSharePrincipalID(shareReq), err))
+			return diags
+		}
+	}
+	principalTypes, err := resourcePrincipalTypes(c, res, unshare, emails)
+	if err != nil {
+		diags.AddError("Error reading workspace API keys", err.Error())
+		return diags
+	}
+	for _, principalID := range unshare {
+		principalType, ok := principalTypes[principalID]
+		if !ok {
+			addUnknownPrincipalWarning(&diags, resourceID, principalID)
+			continue
+		}
+		unshareReq := resourceUnsharePrincipal(resourceType, principalID, principalType, emails)
+		if err := c.UnshareResource(resourceID, unshareReq); err != nil {
+			diags.AddError("Error unsharing resource", fmt.Sprintf("Could not remove access of %s: %s", resourceSharePrincipalID(unshareReq), err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// diffResourceGrants returns the grants to share and the principal IDs to
+// unshare. Emails that do not belong to a workspace member cannot be compared
+// with the current grants and are always shared again.
+func diffResourceGrants(current map[string]string, desired []*models.ShareWorkspaceResourceRequest, userIDs map[string]string) (share []*models.ShareWorkspaceResourceRequest, unshare []string) {
+	wanted := make(map[string]bool, len(desired))
+	for _, shareReq := range desired {
+		principalID := resourcePrincipalID(shareReq, userIDs)
+		if principalID != "" {
+			wanted[principalID] = true
+			if current[principalID] == shareReq.Role {
+				continue
+			}
+		}
+		share = append(share, shareReq)
+	}
+
+	for principalID := range current {
+		if !wanted[principalID] {
+			unshare = append(unshare, principalID)
+		}
+	}
+	sort.Strings(unshare)
+	return share, unshare
+}
+
+// Principal types as reported in the share options of a resource.
+const (
+	resourcePrincipalTypeUser  = "user"
+	resourcePrincipalTypeGroup = "group"
+	resourcePrincipalTypeKey   = "key"
+)
+
+// resourcePrincipalTypes works out whether each principal is a user, a group
+// or a workspace API key. Workspace members are users and the share options
+// report the type of the other principals they list. Any principal left is
+// looked up among the API keys of the workspace service accounts. Principals
+// that are still unresolved are left out of the result, since the API cannot
+// confirm they are groups. User emails found in the share options are added to
+// emails.
+func resourcePrincipalTypes(c *client.Client, res *models.WorkspaceResource, principalIDs []string, emails map[string]string) (map[string]string, error) {
+	principalTypes := make(map[string]string, len(principalIDs))
+	var unresolved []string
+	for _, principalID := range principalIDs {
+		if _, ok := emails[principalID]; ok {
+			principalTypes[principalID] = resourcePrincipalTypeUser
+			continue
+		}
+		for _, option := range res.ShareOptions {
+			if option.ID == principalID {
+				principalTypes[principalID] = option.Type
+				if option.Type == resourcePrincipalTypeUser {
+					emails[principalID] = option.Name
+				}
+				break
+			}
+		}
+		if principalTypes[principalID] == "" {
+			unresolved = append(unresolved, principalID)
+		}
+	}
+	if len(unresolved) == 0 {
+		return principalTypes, nil
+	}
+
+	keyIDs, err := workspaceAPIKeyIDs(c)
+	if err != nil {
+		return nil, err
+	}
+	for _, principalID := range unresolved {
+		if keyIDs[principalID] {
+			principalTypes[principalID] = resourcePrincipalTypeKey
+		}
+	}
+	return principalTypes, nil
+}
+
+// workspaceAPIKeyIDs returns the IDs of the API keys of every workspace
+// service account. The service account listing includes the keys; they are
+// only fetched per account when the listing omits them.
+func workspaceAPIKeyIDs(c *client.Client) (map[string]bool, error) {
+	accounts, err := c.GetWorkspaceServiceAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	keyIDs := make(map[string]bool)
+	for _, account := range accounts {
+		keys := account.APIKeys
+		if keys == nil {
+			keys, err = c.GetServiceAccountAPIKeys(account.ServiceAccountUserID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, key := range keys {
+			keyIDs[key.KeyID] = true
+		}
+	}
+	return keyIDs, nil
+}
+
+// addUnknownPrincipalWarning reports a principal with access to a resource
+// whose type could not be determined, so its access cannot be removed.
+func addUnknownPrincipalWarning(diags *diag.Diagnostics, resourceID, principalID string) {
+	diags.AddWarning(
+		"Unknown Resource Principal",
+		fmt.Sprintf("%s has access to resource %s but is not a workspace member, a share option or a workspace API key, so its type is unknown and its access is left in place. Remove it in the ElevenLabs dashboard if it should not have access.", principalID, resourceID),
+	)
+}
+
+// resourceUnsharePrincipal builds the request that targets a principal that
+// currently has access to a resource.
+func resourceUnsharePrincipal(resourceType, principalID, principalType string, emails map[string]string) *models.ShareWorkspaceResourceRequest {
+	shareReq := &models.ShareWorkspaceResourceRequest{ResourceType: resourceType}
+	switch {
+	case principalType == resourcePrincipalTypeUser && emails[principalID] != "":
+		shareReq.UserEmail = emails[principalID]
+	case principalType == resourcePrincipalTypeKey:
+		shareReq.WorkspaceAPIKeyID = principalID
+	default:
+		shareReq.GroupID = principalID
+	}
+	return shareReq
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+type testResourceACL struct {
+	mu    sync.Mutex
+	roles map[string]string
+}
+
+func (a *testResourceACL) routes() []testRoute {
+	userIDs := map[string]string{"alice@example.com": "user-alice", "bob@example.com": "user-bob"}
+
+	keyIDs := map[string]bool{"key-ci": true, "key-legacy": true}
+
+	principalID := func(body models.ShareWorkspaceResourceRequest) string {
+		switch {
+		case body.GroupID != "":
+			return body.GroupID
+		case body.WorkspaceAPIKeyID != "":
+			return body.WorkspaceAPIKeyID
+		default:
+			return userIDs[body.UserEmail]
+		}
+	}
+
+	return []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/members",
+			Body:   `[{"user_id":"user-alice","email":"alice@example.com"},{"user_id":"user-bob","email":"bob@example.com"}]`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/service-accounts",
+			Body:   `[{"service_account_user_id":"sa-ci","name":"CI","api-keys":[{"key_id":"key-ci","name":"CI"}]},{"service_account_user_id":"sa-legacy","name":"Legacy"}]`,
+		},
+		{
+			// Only fetched for accounts listed without their keys.
+			Method: httpMethodGet,
+			Path:   "/service-accounts/sa-legacy/api-keys",
+			Body:   `{"api-keys":[{"key_id":"key-legacy","name":"Legacy"}]}`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/resources/voice-123",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				a.mu.Lock()
+				defer a.mu.Unlock()
+				res := models.WorkspaceResource{
+					ResourceID:     "voice-123",
+					ResourceType:   "voice",
+					CreatorUserID:  "user-owner",
+					RoleToGroupIDs: map[string][]string{"admin": {"user-owner"}},
+					ShareOptions:   []models.ResourceShareOption{{ID: "group-legacy", Name: "Legacy", Type: "group"}},
+				}
+				for id, role := range a.roles {
+					res.RoleToGroupIDs[role] = append(res.RoleToGroupIDs[role], id)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(res)
+			},
+		},
+		{
+			Method: httpMethodPost,
+			Path:   "/workspace/resources/voice-123/share",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body models.ShareWorkspaceResourceRequest
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				a.mu.Lock()
+				a.roles[principalID(body)] = body.Role
+				a.mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{}`))
+			},
+		},
+		{
+			Method: httpMethodPost,
+			Path:   "/workspace/resources/voice-123/unshare",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body models.ShareWorkspaceResourceRequest
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				if keyIDs[body.GroupID] {
+					http.Error(w, "group not found: "+body.GroupID, http.StatusNotFound)
+					return
+				}
+				a.mu.Lock()
+				delete(a.roles, principalID(body))
+				a.mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{}`))
+			},
+		},
+	}
+}
+
+func (a *testResourceACL) check(expected ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		grants := make([]string, 0, len(a.roles))
+		for id, role := range a.roles {
+			grants = append(grants, id+"="+role)
+		}
+		sort.Strings(grants)
+		if got := strings.Join(grants, ","); got != strings.Join(expected, ",") {
+			return fmt.Errorf("expected grants %v, got %s", expected, got)
+		}
+		return nil
+	}
+}
+
+func TestAccResourceAccessResource(t *testing.T) {
+	acl := &testResourceACL{
+		roles: map[string]string{"user-bob": "editor", "group-legacy": "viewer", "key-legacy": "viewer", "principal-unknown": "viewer"},
+	}
+
+	server := newTestServer(t, acl.routes())
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_resource_access" "voice" {
+  resource_id   = "voice-123"
+  resource_type = "voice"
+
+  grants = [
+    { email = "alice@example.com", role = "editor" },
+    { group_id = "group-support", role = "viewer" },
+    { workspace_api_key_id = "key-ci", role = "viewer" },
+  ]
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_resource_access.voice", "id", "voice:voice-123"),
+					resource.TestCheckResourceAttr("elevenlabs_resource_access.voice", "creator_user_id", "user-owner"),
+					resource.TestCheckResourceAttr("elevenlabs_resource_access.voice", "grants.#", "3"),
+					// A principal of unknown type is left in place with a warning.
+					acl.check("group-support=viewer", "key-ci=viewer", "principal-unknown=viewer", "user-alice=editor"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_resource_access" "voice" {
+  resource_id   = "voice-123"
+  resource_type = "voice"
+
+  grants = [
+    { email = "alice@example.com", role = "viewer" },
+    { group_id = "default", role = "commenter" },
+  ]
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_resource_access.voice", "grants.#", "2"),
+					acl.check("default=commenter", "principal-unknown=viewer", "user-alice=viewer"),
+				),
+			},
+			{
+				ResourceName:            "elevenlabs_resource_access.voice",
+				ImportState:             true,
+				ImportStateId:           "voice:voice-123",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"workspace"},
+			},
+		},
+	})
+}
+
+func TestDiffResourceGrants(t *testing.T) {
+	current := map[string]string{"user-alice": "viewer", "user-bob": "editor", "group-legacy": "viewer"}
+	userIDs := map[string]string{"alice@example.com": "user-alice", "bob@example.com": "user-bob"}
+
+	var desired []*models.ShareWorkspaceResourceRequest
+	for _, grant := range []ResourceAccessGrantModel{
+		{Role: types.StringValue("viewer"), Email: types.StringValue("Alice@example.com")},
+		{Role: types.StringValue("viewer"), Email: types.StringValue("bob@example.com")},
+		{Role: types.StringValue("viewer"), Email: types.StringValue("sa@example.com")},
+	} {
+		shareReq, err := resourceSharePrincipal("voice", grant.Email, grant.GroupID, grant.WorkspaceAPIKeyID)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		shareReq.Role = grant.Role.ValueString()
+		desired = append(desired, shareReq)
+	}
+
+	share, unshare := diffResourceGrants(current, desired, userIDs)
+	if len(share) != 2 || share[0].UserEmail != "bob@example.com" || share[1].UserEmail != "sa@example.com" {
+		t.Errorf("Expected bob's role change and the unmatched service account to be shared, got %+v", share)
+	}
+	if strings.Join(unshare, ",") != "group-legacy" {
+		t.Errorf("Expected group-legacy to be unshared, got %v", unshare)
+	}
+
+	if _, err := resourceSharePrincipal("voice", types.StringValue("a@example.com"), types.StringValue("group-1"), types.StringNull()); err == nil {
+		t.Errorf("Expected an error when more than one principal is set")
+	}
+	if _, err := resourceSharePrincipal("voice", types.StringNull(), types.StringNull(), types.StringNull()); err == nil {
+		t.Errorf("Expected an error when no principal is set")
+	}
+}
+
+func TestResourcePrincipalTypes(t *testing.T) {
+	acl := &testResourceACL{}
+	server := newTestServer(t, acl.routes())
+	defer server.Close()
+
+	res := &models.WorkspaceResource{
+		ShareOptions: []models.ResourceShareOption{
+			{ID: "user-carol", Name: "carol@example.com", Type: "user"},
+			{ID: "group-ops", Name: "Ops", Type: "group"},
+		},
+	}
+	emails := map[string]string{"user-alice": "alice@example.com"}
+	principalIDs := []string{"user-alice", "user-carol", "group-ops", "key-ci", "key-legacy", "group-legacy"}
+
+	principalTypes, err := resourcePrincipalTypes(client.NewClient("test-key", server.URL), res, principalIDs, emails)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := map[string]*models.ShareWorkspaceResourceRequest{
+		"user-alice": {ResourceType: "voice", UserEmail: "alice@example.com"},
+		"user-carol": {ResourceType: "voice", UserEmail: "carol@example.com"},
+		"group-ops":  {ResourceType: "voice", GroupID: "group-ops"},
+		"key-ci":     {ResourceType: "voice", WorkspaceAPIKeyID: "key-ci"},
+		"key-legacy": {ResourceType: "voice", WorkspaceAPIKeyID: "key-legacy"},
+	}
+	if principalType, ok := principalTypes["group-legacy"]; ok {
+		t.Errorf("group-legacy: expected an unknown principal type, got %q", principalType)
+	}
+	for principalID := range want {
+		got := resourceUnsharePrincipal("voice", principalID, principalTypes[principalID], emails)
+		if *got != *want[principalID] {
+			t.Errorf("%s: expected %+v, got %+v", principalID, *want[principalID], *got)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+var (
+	_ resource.Resource              = &ResourceCopyResource{}
+	_ resource.ResourceWithConfigure = &ResourceCopyResource{}
+)
+
+func NewResourceCopyResource() resource.Resource {
+	return &ResourceCopyResource{}
+}
+
+type ResourceCopyResource struct {
+	client *client.Client
+}
+
+type ResourceCopyResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ResourceID      types.String `tfsdk:"resource_id"`
+	ResourceType    types.String `tfsdk:"resource_type"`
+	TargetUserID    types.String `tfsdk:"target_user_id"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+	NewResourceID   types.String `tfsdk:"new_resource_id"`
+	TargetWorkspace types.String `tfsdk:"target_workspace"`
+	Workspace       types.String `tfsdk:"workspace"`
+}
+
+func (r *ResourceCopyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_copy"
+}
+
+func (r *ResourceCopyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Copies a workspace resource, such as a voice, agent or pronunciation dictionary, to another workspace. " +
+			"The copy is made once on create and again whenever `triggers` change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the resource to copy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Type of resource: `voice`, `pronunciation_dictionary`, `convai_agents`, etc.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the user in the target workspace who receives the copy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that copy the resource again when they change.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"new_resource_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the copy in the target workspace. Only known when `target_workspace` is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_workspace": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Name of a workspace from the provider `workspaces` map whose API key can list the resources of the target workspace. " +
+					"The API does not report the ID of the copy, so it is found by comparing the resources `target_user_id` owns before and after the copy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace": workspaceAttribute(),
+		},
+	}
+}
+
+func (r *ResourceCopyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ResourceCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ResourceCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resources the receiving user already owns are recorded first so that
+	// the copy can be told apart from them afterwards.
+	var target *client.Client
+	var existing map[string]bool
+	if !data.TargetWorkspace.IsNull() {
+		target, diags = clientForWorkspace(r.client, data.TargetWorkspace)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		owned, err := ownedWorkspaceResources(target, data.ResourceType.ValueString(), data.TargetUserID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing target workspace resources", err.Error())
+			return
+		}
+		existing = make(map[string]bool, len(owned))
+		for _, res := range owned {
+			existing[res.ResourceID] = true
+		}
+	}
+
+	err := c.CopyResourceToWorkspace(data.ResourceID.ValueString(), &models.CopyWorkspaceResourceRequest{
+		ResourceType: data.ResourceType.ValueString(),
+		TargetUserID: data.TargetUserID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error copying resource", err.Error())
+		return
+	}
+
+	data.NewResourceID = types.StringNull()
+	if target != nil {
+		newResourceID, err := findCopiedResource(c, target, &data, existing)
+		if err != nil {
+			// The copy exists, so failing here would only make another one.
+			resp.Diagnostics.AddAttributeWarning(path.Root("new_resource_id"), "Copy Not Identified",
+				fmt.Sprintf("The resource was copied, but the copy could not be found in the target workspace: %s", err))
+		}
+		data.NewResourceID = optionalStringValue(newResourceID)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", data.ResourceType.ValueString(), data.ResourceID.ValueString(), data.TargetUserID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ResourceCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The copy lives in another workspace and is not tracked after it is made.
+	var data ResourceCopyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ResourceCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument except workspace forces a new copy.
+	var data ResourceCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ResourceCopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Copies belong to the target workspace and are kept when this resource
+	// is destroyed or replaced.
+}
+
+// ownedWorkspaceResources lists the resources of a type that a user owns.
+func ownedWorkspaceResources(c *client.Client, resourceType, ownerID string) ([]models.WorkspaceResource, error) {
+	resources, err := c.GetWorkspaceResources()
+	if err != nil {
+		return nil, err
+	}
+
+	var owned []models.WorkspaceResource
+	for _, res := range resources {
+		if res.ResourceType == resourceType && res.OwnerID == ownerID {
+			owned = append(owned, res)
+		}
+	}
+	return owned, nil
+}
+
+// findCopiedResource returns the ID of the resource the receiving user gained
+// through the copy. When several appeared, for example because of a
+// concurrent copy, the one with the name of the source resource is used.
+func findCopiedResource(source, target *client.Client, data *ResourceCopyResourceModel, existing map[string]bool) (string, error) {
+	owned, err := ownedWorkspaceResources(target, data.ResourceType.ValueString(), data.TargetUserID.ValueString())
+	if err != nil {
+		return "", err
+	}
+
+	var candidates []models.WorkspaceResource
+	for _, res := range owned {
+		if !existing[res.ResourceID] {
+			candidates = append(candidates, res)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0].ResourceID, nil
+	}
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("no new %s owned by %s was found", data.ResourceType.ValueString(), data.TargetUserID.ValueString())
+	}
+
+	resources, err := source.GetWorkspaceResources()
+	if err != nil {
+		return "", err
+	}
+	name := ""
+	for _, res := range resources {
+		if res.ResourceID == data.ResourceID.ValueString() {
+			name = res.Name
+			break
+		}
+	}
+
+	var named []string
+	for _, res := range candidates {
+		if name != "" && res.Name == name {
+			named = append(named, res.ResourceID)
+		}
+	}
+	if len(named) != 1 {
+		return "", fmt.Errorf("%d new resources of type %s owned by %s were found and the copy could not be told apart by name", len(candidates), data.ResourceType.ValueString(), data.TargetUserID.ValueString())
+	}
+	return named[0], nil
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+// testResourceCopies fakes a source and a target workspace, told apart by API
+// key. Copies answer with an empty body, as the API does.
+type testResourceCopies struct {
+	mu     sync.Mutex
+	copies []models.CopyWorkspaceResourceRequest
+	target []models.WorkspaceResource
+}
+
+func (f *testResourceCopies) routes() []testRoute {
+	return []testRoute{
+		{
+			Method: httpMethodPost,
+			Path:   "/workspace/resources/voice-123/copy-to-workspace",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body models.CopyWorkspaceResourceRequest
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				f.mu.Lock()
+				f.copies = append(f.copies, body)
+				f.target = append(f.target, models.WorkspaceResource{
+					ResourceID:   fmt.Sprintf("voice-copy-%d", len(f.copies)),
+					ResourceType: body.ResourceType,
+					Name:         "Narrator",
+					OwnerID:      body.TargetUserID,
+				})
+				f.mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{}`))
+			},
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/resources",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				f.mu.Lock()
+				defer f.mu.Unlock()
+				resources := []models.WorkspaceResource{{ResourceID: "voice-123", ResourceType: "voice", Name: "Narrator", OwnerID: "user-staging"}}
+				if r.Header.Get("xi-api-key") == "prod-key" {
+					resources = append([]models.WorkspaceResource{
+						{ResourceID: "voice-old", ResourceType: "voice", Name: "Narrator", OwnerID: "user-prod"},
+						{ResourceID: "agent-1", ResourceType: "convai_agents", Name: "Support", OwnerID: "user-prod"},
+					}, f.target...)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(resources)
+			},
+		},
+	}
+}
+
+func TestAccResourceCopyResource(t *testing.T) {
+	fake := &testResourceCopies{}
+	server := newTestServer(t, fake.routes())
+	defer server.Close()
+
+	checkCopies := func(expected int) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			fake.mu.Lock()
+			defer fake.mu.Unlock()
+			if len(fake.copies) != expected {
+				return fmt.Errorf("expected %d copies, got %d", expected, len(fake.copies))
+			}
+			if fake.copies[len(fake.copies)-1].TargetUserID != "user-prod" || fake.copies[len(fake.copies)-1].ResourceType != "voice" {
+				return fmt.Errorf("unexpected copy request: %+v", fake.copies[len(fake.copies)-1])
+			}
+			return nil
+		}
+	}
+
+	config := func(release string) string {
+		return fmt.Sprintf(`
+provider "elevenlabs" {
+  api_key  = "test-key"
+  base_url = %q
+
+  workspaces = {
+    production = {
+      api_key = "prod-key"
+    }
+  }
+}
+
+resource "elevenlabs_resource_copy" "narrator" {
+  resource_id      = "voice-123"
+  resource_type    = "voice"
+  target_user_id   = "user-prod"
+  target_workspace = "production"
+  triggers = {
+    release = %q
+  }
+}
+`, server.URL, release)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_resource_copy.narrator", "id", "voice:voice-123:user-prod"),
+					resource.TestCheckResourceAttr("elevenlabs_resource_copy.narrator", "new_resource_id", "voice-copy-1"),
+					checkCopies(1),
+				),
+			},
+			{
+				Config: config("1"),
+				Check:  checkCopies(1),
+			},
+			{
+				Config: config("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_resource_copy.narrator", "new_resource_id", "voice-copy-2"),
+					checkCopies(2),
+				),
+			},
+		},
+	})
+}
+
+func TestFindCopiedResource(t *testing.T) {
+	fake := &testResourceCopies{}
+	server := newTestServer(t, fake.routes())
+	defer server.Close()
+
+	source := client.NewClient("test-key", server.URL)
+	target := client.NewClient("prod-key", server.URL)
+	data := &ResourceCopyResourceModel{
+		ResourceID:   types.StringValue("voice-123"),
+		ResourceType: types.StringValue("voice"),
+		TargetUserID: types.StringValue("user-prod"),
+	}
+	existing := map[string]bool{"voice-old": true}
+
+	if _, err := findCopiedResource(source, target, data, existing); err == nil || !strings.Contains(err.Error(), "no new voice") {
+		t.Errorf("Expected an error when nothing was copied, got %v", err)
+	}
+
+	fake.target = []models.WorkspaceResource{{ResourceID: "voice-copy-1", ResourceType: "voice", Name: "Narrator", OwnerID: "user-prod"}}
+	if id, err := findCopiedResource(source, target, data, existing); err != nil || id != "voice-copy-1" {
+		t.Errorf("Expected voice-copy-1, got %q (%v)", id, err)
+	}
+
+	// A concurrent copy of another voice is told apart by name.
+	fake.target = append(fake.target, models.WorkspaceResource{ResourceID: "voice-other", ResourceType: "voice", Name: "Announcer", OwnerID: "user-prod"})
+	if id, err := findCopiedResource(source, target, data, existing); err != nil || id != "voice-copy-1" {
+		t.Errorf("Expected voice-copy-1, got %q (%v)", id, err)
+	}
+
+	fake.target = append(fake.target, models.WorkspaceResource{ResourceID: "voice-copy-2", ResourceType: "voice", Name: "Narrator", OwnerID: "user-prod"})
+	if _, err := findCopiedResource(source, target, data, existing); err == nil {
+		t.Errorf("Expected an error when the copy is ambiguous")
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
 )
 
 var (
@@ -28,20 +30,26 @@ type ResourceShareResource struct {
 }
 
 type ResourceShareResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	ResourceID   types.String `tfsdk:"resource_id"`
-	ResourceType types.String `tfsdk:"resource_type"`
-	Email        types.String `tfsdk:"email"`
-	Role         types.String `tfsdk:"role"`
+	ID                types.String `tfsdk:"id"`
+	ResourceID        types.String `tfsdk:"resource_id"`
+	ResourceType      types.String `tfsdk:"resource_type"`
+	Email             types.String `tfsdk:"email"`
+	GroupID           types.String `tfsdk:"group_id"`
+	WorkspaceAPIKeyID types.String `tfsdk:"workspace_api_key_id"`
+	Role              types.String `tfsdk:"role"`
+	Workspace         types.String `tfsdk:"workspace"`
 }
 
+// resourceShareRoles lists the sharing roles from most to least privileged.
+var resourceShareRoles = []string{"admin", "editor", "commenter", "viewer"}
+
 func (r *ResourceShareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_resource_share"
 }
 
 func (r *ResourceShareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Resource Share resource for ElevenLabs. Allows sharing voices, agents, etc. with users.",
+		MarkdownDescription: "Resource Share resource for ElevenLabs. Allows sharing voices, agents, etc. with a user, group or workspace API key.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -63,14 +71,31 @@ func (r *ResourceShareResource) Schema(ctx context.Context, req resource.SchemaR
 				MarkdownDescription: "Type of resource: `voice`, `agent`, etc.",
 			},
 			"email": schema.StringAttribute{
-				Required: true,
+				Optional:            true,
+				MarkdownDescription: "Email of the user or service account to share with.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the workspace group to share with. Use `default` to set the access every workspace member has.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_api_key_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the workspace API key to share with. This is not the key itself.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"role": schema.StringAttribute{
-				Required: true,
+				Required:            true,
+				MarkdownDescription: "Role to grant: `admin`, `editor`, `commenter` or `viewer`.",
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -99,30 +124,104 @@ func (r *ResourceShareResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	err := r.client.ShareResource(data.ResourceID.ValueString(), data.ResourceType.ValueString(), data.Email.ValueString(), data.Role.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shareReq, err := resourceSharePrincipal(data.ResourceType.ValueString(), data.Email, data.GroupID, data.WorkspaceAPIKeyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	shareReq.Role = data.Role.ValueString()
+
+	err = c.ShareResource(data.ResourceID.ValueString(), shareReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error sharing resource", err.Error())
 		return
 	}
 
-	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", data.ResourceType.ValueString(), data.ResourceID.ValueString(), data.Email.ValueString()))
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", data.ResourceType.ValueString(), data.ResourceID.ValueString(), resourceSharePrincipalID(shareReq)))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ResourceShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// API logic to check current sharing state
+	var data ResourceShareResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shareReq, err := resourceSharePrincipal(data.ResourceType.ValueString(), data.Email, data.GroupID, data.WorkspaceAPIKeyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid State", err.Error())
+		return
+	}
+
+	res, err := c.GetWorkspaceResource(data.ResourceID.ValueString(), data.ResourceType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading shared resource", err.Error())
+		return
+	}
+
+	var userIDs map[string]string
+	if shareReq.UserEmail != "" {
+		userIDs, _, err = workspaceMemberUserIDs(c)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading workspace members", err.Error())
+			return
+		}
+	}
+
+	principalID := resourcePrincipalID(shareReq, userIDs)
+	if principalID == "" {
+		// Service accounts are not workspace members, so their grants
+		// cannot be matched and the recorded role is kept.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	role, ok := resourcePrincipalRoles(res)[principalID]
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Role = types.StringValue(role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ResourceShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Role update might be possible via re-share or specific endpoint
+	// Sharing again with the same principal replaces its role.
 	var data ResourceShareResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.ShareResource(data.ResourceID.ValueString(), data.ResourceType.ValueString(), data.Email.ValueString(), data.Role.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shareReq, err := resourceSharePrincipal(data.ResourceType.ValueString(), data.Email, data.GroupID, data.WorkspaceAPIKeyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	shareReq.Role = data.Role.ValueString()
+
+	err = c.ShareResource(data.ResourceID.ValueString(), shareReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating resource share", err.Error())
 		return
@@ -138,7 +237,19 @@ func (r *ResourceShareResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err := r.client.UnshareResource(data.ResourceID.ValueString(), data.ResourceType.ValueString(), data.Email.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shareReq, err := resourceSharePrincipal(data.ResourceType.ValueString(), data.Email, data.GroupID, data.WorkspaceAPIKeyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid State", err.Error())
+		return
+	}
+
+	err = c.UnshareResource(data.ResourceID.ValueString(), shareReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error unsharing resource", err.Error())
 		return
@@ -146,5 +257,107 @@ func (r *ResourceShareResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *ResourceShareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importStateWorkspace(ctx, req.ID, 1, resp)
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: resource_type:resource_id:principal, where principal is an email, group:<group_id> or key:<workspace_api_key_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), parts[1])...)
+
+	principal := parts[2]
+	switch {
+	case strings.HasPrefix(principal, "group:"):
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), strings.TrimPrefix(principal, "group:"))...)
+	case strings.HasPrefix(principal, "key:"):
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_api_key_id"), strings.TrimPrefix(principal, "key:"))...)
+	default:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), principal)...)
+	}
+}
+
+// resourceSharePrincipal builds a share request for the single principal
+// that is set.
+func resourceSharePrincipal(resourceType string, email, groupID, workspaceAPIKeyID types.String) (*models.ShareWorkspaceResourceRequest, error) {
+	shareReq := &models.ShareWorkspaceResourceRequest{
+		ResourceType:      resourceType,
+		UserEmail:         email.ValueString(),
+		GroupID:           groupID.ValueString(),
+		WorkspaceAPIKeyID: workspaceAPIKeyID.ValueString(),
+	}
+
+	set := 0
+	for _, value := range []string{shareReq.UserEmail, shareReq.GroupID, shareReq.WorkspaceAPIKeyID} {
+		if value != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("exactly one of email, group_id or workspace_api_key_id must be set")
+	}
+	return shareReq, nil
+}
+
+// resourceSharePrincipalID returns the principal part of a resource share ID.
+func resourceSharePrincipalID(shareReq *models.ShareWorkspaceResourceRequest) string {
+	switch {
+	case shareReq.GroupID != "":
+		return "group:" + shareReq.GroupID
+	case shareReq.WorkspaceAPIKeyID != "":
+		return "key:" + shareReq.WorkspaceAPIKeyID
+	default:
+		return shareReq.UserEmail
+	}
+}
+
+// resourcePrincipalID returns the ID under which the API reports the grants
+// of a principal, or "" when an email does not belong to a workspace member.
+// Users are reported under their user ID.
+func resourcePrincipalID(shareReq *models.ShareWorkspaceResourceRequest, userIDs map[string]string) string {
+	switch {
+	case shareReq.GroupID != "":
+		return shareReq.GroupID
+	case shareReq.WorkspaceAPIKeyID != "":
+		return shareReq.WorkspaceAPIKeyID
+	default:
+		return userIDs[strings.ToLower(shareReq.UserEmail)]
+	}
+}
+
+// resourcePrincipalRoles maps every principal with access to the resource to
+// its most privileged role. The creator always has access and is left out.
+func resourcePrincipalRoles(res *models.WorkspaceResource) map[string]string {
+	roles := make(map[string]string)
+	for i := len(resourceShareRoles) - 1; i >= 0; i-- {
+		role := resourceShareRoles[i]
+		for _, principalID := range res.RoleToGroupIDs[role] {
+			if principalID != res.CreatorUserID {
+				roles[principalID] = role
+			}
+		}
+	}
+	return roles
+}
+
+// workspaceMemberUserIDs maps the lowercased emails of workspace members to
+// their user IDs, and the user IDs back to the emails.
+func workspaceMemberUserIDs(c *client.Client) (userIDs, emails map[string]string, err error) {
+	members, err := c.GetWorkspaceMembers()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userIDs = make(map[string]string, len(members))
+	emails = make(map[string]string, len(members))
+	for _, member := range members {
+		userIDs[strings.ToLower(member.Email)] = member.UserID
+		emails[member.UserID] = member.Email
+	}
+	return userIDs, emails, nil
 }
@@ -3,10 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,9 +20,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &ServiceAccountKeyResource{}
-	_ resource.ResourceWithConfigure   = &ServiceAccountKeyResource{}
-	_ resource.ResourceWithImportState = &ServiceAccountKeyResource{}
+	_ resource.Resource                   = &ServiceAccountKeyResource{}
+	_ resource.ResourceWithConfigure      = &ServiceAccountKeyResource{}
+	_ resource.ResourceWithImportState    = &ServiceAccountKeyResource{}
+	_ resource.ResourceWithModifyPlan     = &ServiceAccountKeyResource{}
+	_ resource.ResourceWithValidateConfig = &ServiceAccountKeyResource{}
 )
 
 func NewServiceAccountKeyResource() resource.Resource {
@@ -29,12 +36,18 @@ type ServiceAccountKeyResource struct {
 }
 
 type ServiceAccountKeyResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	UserID         types.String `tfsdk:"user_id"`
-	Name           types.String `tfsdk:"name"`
-	XiApiKey       types.String `tfsdk:"api_key"`
-	Permissions    types.List   `tfsdk:"permissions"`
-	CharacterLimit types.Int64  `tfsdk:"character_limit"`
+	ID               types.String `tfsdk:"id"`
+	UserID           types.String `tfsdk:"user_id"`
+	Name             types.String `tfsdk:"name"`
+	XiApiKey         types.String `tfsdk:"api_key"`
+	Permissions      types.List   `tfsdk:"permissions"`
+	CharacterLimit   types.Int64  `tfsdk:"character_limit"`
+	IsDisabled       types.Bool   `tfsdk:"is_disabled"`
+	Hint             types.String `tfsdk:"hint"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	RotateAfter      types.String `tfsdk:"rotate_after"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+	Workspace        types.String `tfsdk:"workspace"`
 }
 
 func (r *ServiceAccountKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,7 +56,7 @@ func (r *ServiceAccountKeyResource) Metadata(ctx context.Context, req resource.M
 
 func (r *ServiceAccountKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Service Account Key resource for ElevenLabs. Allows managing API keys for service accounts.",
+		MarkdownDescription: "Service Account Key resource for ElevenLabs. Allows managing and rotating API keys for service accounts.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -64,14 +77,48 @@ func (r *ServiceAccountKeyResource) Schema(ctx context.Context, req resource.Sch
 			"api_key": schema.StringAttribute{
 				Computed:  true,
 				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"permissions": schema.ListAttribute{
 				ElementType: types.StringType,
 				Required:    true,
 			},
 			"character_limit": schema.Int64Attribute{
-				Optional: true,
+				Optional:            true,
+				MarkdownDescription: "Monthly character limit of the key.",
+			},
+			"is_disabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the key is disabled. Defaults to `false`.",
+			},
+			"hint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The visible part of the key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the current key was created, in RFC 3339 format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_after": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Rotate the key once it is older than this Go duration, e.g. `720h`. Rotation happens on the first apply after the key expires.",
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that rotate the key when they change.",
+			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -93,6 +140,52 @@ func (r *ServiceAccountKeyResource) Configure(ctx context.Context, req resource.
 	r.client = client
 }
 
+func (r *ServiceAccountKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rotateAfter types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rotate_after"), &rotateAfter)...)
+	if resp.Diagnostics.HasError() || rotateAfter.IsNull() || rotateAfter.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(rotateAfter.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rotate_after"), "Invalid Configuration", fmt.Sprintf("rotate_after must be a duration such as \"720h\": %s", err))
+	}
+}
+
+// ModifyPlan marks the key as rotating when rotation_triggers change or the
+// key is older than rotate_after. Update then replaces the key in place.
+func (r *ServiceAccountKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ServiceAccountKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotate := !plan.RotationTriggers.IsUnknown() && !plan.RotationTriggers.Equal(state.RotationTriggers)
+
+	if !plan.RotateAfter.IsNull() && !plan.RotateAfter.IsUnknown() {
+		// rotate_after was checked by ValidateConfig.
+		rotateAfter, _ := time.ParseDuration(plan.RotateAfter.ValueString())
+		createdAt, err := time.Parse(time.RFC3339, state.CreatedAt.ValueString())
+		if err == nil && time.Now().After(createdAt.Add(rotateAfter)) {
+			rotate = true
+		}
+	}
+
+	if !rotate {
+		return
+	}
+
+	for _, attr := range []string{"id", "api_key", "hint", "created_at"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr), types.StringUnknown())...)
+	}
+}
+
 func (r *ServiceAccountKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ServiceAccountKeyResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -100,46 +193,108 @@ func (r *ServiceAccountKeyResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	var permissions []string
-	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	addReq := &models.CreateServiceAccountKeyRequest{
-		Name: data.Name.ValueString(),
+	resp.Diagnostics.Append(r.createKey(ctx, c, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &addReq.Permissions, false)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceAccountKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServiceAccountKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if !data.CharacterLimit.IsNull() {
-		addReq.CharacterLimit = int(data.CharacterLimit.ValueInt64())
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	key, err := r.client.CreateServiceAccountKey(data.UserID.ValueString(), addReq)
+	key, err := findServiceAccountKey(c, data.UserID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating service account key", err.Error())
+		resp.Diagnostics.AddError("Error reading service account keys", err.Error())
+		return
+	}
+	if key == nil {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	data.ID = types.StringValue(key.KeyID)
-	if key.XiApiKey != "" {
-		data.XiApiKey = types.StringValue(key.XiApiKey)
+	data.Name = types.StringValue(key.Name)
+	data.IsDisabled = types.BoolValue(key.IsDisabled)
+	data.Hint = optionalStringValue(key.Hint)
+	if key.CharacterLimit != nil {
+		data.CharacterLimit = types.Int64Value(int64(*key.CharacterLimit))
 	} else {
-		data.XiApiKey = types.StringNull()
+		data.CharacterLimit = types.Int64Null()
+	}
+	if data.CreatedAt.IsNull() && key.CreatedAtUnix > 0 {
+		data.CreatedAt = types.StringValue(time.Unix(key.CreatedAtUnix, 0).UTC().Format(time.RFC3339))
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
+	// Keep the configured order when the API returns the same permissions.
+	current, diags := stringSliceFromList(ctx, data.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if key.Permissions != nil && !sameStrings(current, key.Permissions) {
+		permissions, diags := types.ListValueFrom(ctx, types.StringType, key.Permissions)
+		resp.Diagnostics.Append(diags...)
+		data.Permissions = permissions
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-func (r *ServiceAccountKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// API supports listing keys. We could find the specific key by ID in the list.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ServiceAccountKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning("Update limited", "Updating service account keys might require replacement.")
+	var data, state ServiceAccountKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ID.IsUnknown() {
+		resp.Diagnostics.Append(r.updateKey(ctx, c, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Rotate by creating the replacement before deleting the old key so
+	// that consumers never see a window without a valid key.
+	resp.Diagnostics.Append(r.createKey(ctx, c, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err := c.DeleteServiceAccountKey(state.UserID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting rotated service account key",
+			fmt.Sprintf("The new key %s was created, but the old key %s could not be deleted: %s", data.ID.ValueString(), state.ID.ValueString(), err))
+		return
+	}
 }
 
 func (r *ServiceAccountKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -149,7 +304,13 @@ func (r *ServiceAccountKeyResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	err := r.client.DeleteServiceAccountKey(data.UserID.ValueString(), data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteServiceAccountKey(data.UserID.ValueString(), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting service account key", err.Error())
 		return
@@ -157,6 +318,118 @@ func (r *ServiceAccountKeyResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ServiceAccountKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import requires both userID and keyID, usually handled via a slash-separated string
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importStateWorkspace(ctx, req.ID, 2, resp)
+	userID, keyID, ok := strings.Cut(id, "/")
+	if !ok || userID == "" || keyID == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import ID in the format <user_id>/<key_id>, got %q.", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), keyID)...)
+}
+
+func (r *ServiceAccountKeyResource) createKey(ctx context.Context, c *client.Client, data *ServiceAccountKeyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	addReq := &models.CreateServiceAccountKeyRequest{
+		Name: data.Name.ValueString(),
+	}
+
+	diags.Append(data.Permissions.ElementsAs(ctx, &addReq.Permissions, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	if !data.CharacterLimit.IsNull() {
+		addReq.CharacterLimit = int(data.CharacterLimit.ValueInt64())
+	}
+
+	key, err := c.CreateServiceAccountKey(data.UserID.ValueString(), addReq)
+	if err != nil {
+		diags.AddError("Error creating service account key", err.Error())
+		return diags
+	}
+
+	data.ID = types.StringValue(key.KeyID)
+	if key.XiApiKey != "" {
+		data.XiApiKey = types.StringValue(key.XiApiKey)
+	} else {
+		data.XiApiKey = types.StringNull()
+	}
+	data.CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	data.Hint = types.StringNull()
+
+	// New keys are always enabled, so a disabled key needs a follow-up update.
+	if data.IsDisabled.ValueBool() {
+		diags.Append(r.updateKey(ctx, c, data)...)
+		return diags
+	}
+
+	if created, err := findServiceAccountKey(c, data.UserID.ValueString(), key.KeyID); err == nil && created != nil {
+		data.Hint = optionalStringValue(created.Hint)
+	}
+
+	return diags
+}
+
+func (r *ServiceAccountKeyResource) updateKey(ctx context.Context, c *client.Client, data *ServiceAccountKeyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	updateReq := &models.UpdateServiceAccountKeyRequest{
+		IsEnabled: !data.IsDisabled.ValueBool(),
+		Name:      data.Name.ValueString(),
+	}
+
+	diags.Append(data.Permissions.ElementsAs(ctx, &updateReq.Permissions, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	if !data.CharacterLimit.IsNull() {
+		limit := int(data.CharacterLimit.ValueInt64())
+		updateReq.CharacterLimit = &limit
+	}
+
+	err := c.UpdateServiceAccountKey(data.UserID.ValueString(), data.ID.ValueString(), updateReq)
+	if err != nil {
+		diags.AddError("Error updating service account key", err.Error())
+		return diags
+	}
+
+	if key, err := findServiceAccountKey(c, data.UserID.ValueString(), data.ID.ValueString()); err == nil && key != nil {
+		data.Hint = optionalStringValue(key.Hint)
+	}
+
+	return diags
+}
+
+func findServiceAccountKey(c *client.Client, userID, keyID string) (*models.ServiceAccountKey, error) {
+	keys, err := c.GetServiceAccountAPIKeys(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range keys {
+		if keys[i].KeyID == keyID {
+			return &keys[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// sameStrings reports whether a and b contain the same strings, ignoring order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+type testServiceAccountKeys struct {
+	mu     sync.Mutex
+	nextID int
+	keys   map[string]*models.ServiceAccountKey
+}
+
+func (s *testServiceAccountKeys) routes() []testRoute {
+	routes := []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/service-accounts/sa-123/api-keys",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				resp := models.ServiceAccountKeysResponse{APIKeys: []models.ServiceAccountKey{}}
+				for _, key := range s.keys {
+					resp.APIKeys = append(resp.APIKeys, *key)
+				}
+				sort.Slice(resp.APIKeys, func(i, j int) bool { return resp.APIKeys[i].KeyID < resp.APIKeys[j].KeyID })
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(resp)
+			},
+		},
+		{
+			Method: httpMethodPost,
+			Path:   "/service-accounts/sa-123/api-keys",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body models.CreateServiceAccountKeyRequest
+				_ = json.NewDecoder(r.Body).Decode(&body)
+
+				s.mu.Lock()
+				s.nextID++
+				id := fmt.Sprintf("key-%d", s.nextID)
+				key := &models.ServiceAccountKey{
+					KeyID:                id,
+					Name:                 body.Name,
+					Hint:                 fmt.Sprintf("sk_%d", s.nextID),
+					ServiceAccountUserID: "sa-123",
+					Permissions:          body.Permissions,
+				}
+				if body.CharacterLimit > 0 {
+					limit := body.CharacterLimit
+					key.CharacterLimit = &limit
+				}
+				s.keys[id] = key
+				s.mu.Unlock()
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"key_id":%q,"xi-api-key":"secret-%s"}`, id, id)
+			},
+		},
+	}
+
+	for i := 1; i <= 5; i++ {
+		id := fmt.Sprintf("key-%d", i)
+		routes = append(routes,
+			testRoute{
+				Method: httpMethodPatch,
+				Path:   "/service-accounts/sa-123/api-keys/" + id,
+				Handler: func(w http.ResponseWriter, r *http.Request) {
+					var body models.UpdateServiceAccountKeyRequest
+					_ = json.NewDecoder(r.Body).Decode(&body)
+					s.mu.Lock()
+					if key, ok := s.keys[id]; ok {
+						key.Name = body.Name
+						key.Permissions = body.Permissions
+						key.CharacterLimit = body.CharacterLimit
+						key.IsDisabled = !body.IsEnabled
+					}
+					s.mu.Unlock()
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{}`))
+				},
+			},
+			testRoute{
+				Method: httpMethodDelete,
+				Path:   "/service-accounts/sa-123/api-keys/" + id,
+				Handler: func(w http.ResponseWriter, r *http.Request) {
+					s.mu.Lock()
+					delete(s.keys, id)
+					s.mu.Unlock()
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{}`))
+				},
+			},
+		)
+	}
+
+	return routes
+}
+
+func (s *testServiceAccountKeys) check(expected ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		ids := make([]string, 0, len(s.keys))
+		for id := range s.keys {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		if strings.Join(ids, ",") != strings.Join(expected, ",") {
+			return fmt.Errorf("expected keys %v, got %v", expected, ids)
+		}
+		return nil
+	}
+}
+
+func TestAccServiceAccountKeyResource(t *testing.T) {
+	keys := &testServiceAccountKeys{keys: map[string]*models.ServiceAccountKey{}}
+
+	server := newTestServer(t, keys.routes())
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_service_account_key" "ci" {
+  user_id     = "sa-123"
+  name        = "CI"
+  permissions = ["text_to_speech"]
+  rotation_triggers = {
+    release = "1"
+  }
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_service_account_key.ci", "id", "key-1"),
+					resource.TestCheckResourceAttr("elevenlabs_service_account_key.ci", "api_key", "secret-key-1"),
+					resource.TestCheckResourceAttr("elevenlabs_service_account_key.ci", "hint", "sk_1"),
+					resource.TestCheckResourceAttrSet("elevenlabs_service_account_key.ci", "created_at"),
+					keys.check("key-1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_service_account_key" "ci" {
+  user_id         = "sa-123"
+  name            = "CI renamed"
+  permissions     = ["text_to_speech", "voices_read"]
+  character_limit = 5000
+  is_disabled     = true
+  rotation_triggers = {
+    release = "1"
+  }
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_service_account_key.ci", "id", "key-1"),
+					resource.TestCheckResourceAttr("elevenlabs_service_account_key.ci", "api_key", "secret-key-1"),
+					resource.TestCheckResourceAttr("elevenlabs_service_account_key.ci", "name", "CI renamed"),
+					resource.TestCheckResourceAttr("elevenlabs_service_account_key.ci", "is_disabled", "true"),
+					keys.check("key-1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_service_account_key" "ci" {
+  user_id         = "sa-123"
+  name            = "CI renamed"
+  permissions     = ["text_to_speech", "voices_read"]
+  character_limit = 5000
+  rotation_triggers = {
+    release = "2"
+  }
+}
+
+data "elevenlabs_service_account_keys" "all" {
+  user_id    = "sa-123"
+  depends_on = [elevenlabs_service_account_key.ci]
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_service_account_key.ci", "id", "key-2"),
+					resource.TestCheckResourceAttr("elevenlabs_service_account_key.ci", "api_key", "secret-key-2"),
+					resource.TestCheckResourceAttr("elevenlabs_service_account_key.ci", "is_disabled", "false"),
+					resource.TestCheckResourceAttr("data.elevenlabs_service_account_keys.all", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_service_account_keys.all", "keys.0.key_id", "key-2"),
+					resource.TestCheckResourceAttr("data.elevenlabs_service_account_keys.all", "keys.0.character_limit", "5000"),
+					resource.TestCheckResourceAttr("data.elevenlabs_service_account_keys.all", "keys.0.permissions.#", "2"),
+					keys.check("key-2"),
+				),
+			},
+			{
+				ResourceName:            "elevenlabs_service_account_key.ci",
+				ImportState:             true,
+				ImportStateId:           "sa-123/key-2",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key", "created_at", "rotation_triggers", "workspace"},
+			},
+		},
+	})
+}
+
+func TestServiceAccountKeyResource_ValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		rotateAfter tftypes.Value
+		wantError   bool
+	}{
+		"unset":   {tftypes.NewValue(tftypes.String, nil), false},
+		"valid":   {tftypes.NewValue(tftypes.String, "720h"), false},
+		"unknown": {tftypes.NewValue(tftypes.String, tftypes.UnknownValue), false},
+		"invalid": {tftypes.NewValue(tftypes.String, "30 days"), true},
+	}
+
+	r := NewServiceAccountKeyResource()
+	for name, tt := range tests {
+		diags := validateResourceConfig(t, r, map[string]tftypes.Value{
+			"user_id":      tftypes.NewValue(tftypes.String, "user-123"),
+			"name":         tftypes.NewValue(tftypes.String, "CI"),
+			"rotate_after": tt.rotateAfter,
+		})
+		if diags.HasError() != tt.wantError {
+			t.Errorf("%s: expected error %t, got %v", name, tt.wantError, diags)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &ServiceAccountKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &ServiceAccountKeysDataSource{}
+)
+
+func NewServiceAccountKeysDataSource() datasource.DataSource {
+	return &ServiceAccountKeysDataSource{}
+}
+
+type ServiceAccountKeysDataSource struct {
+	client *client.Client
+}
+
+type ServiceAccountKeysDataSourceModel struct {
+	UserID types.String             `tfsdk:"user_id"`
+	Keys   []ServiceAccountKeyModel `tfsdk:"keys"`
+}
+
+type ServiceAccountKeyModel struct {
+	KeyID          types.String `tfsdk:"key_id"`
+	Name           types.String `tfsdk:"name"`
+	Hint           types.String `tfsdk:"hint"`
+	Permissions    types.List   `tfsdk:"permissions"`
+	CharacterLimit types.Int64  `tfsdk:"character_limit"`
+	CharacterCount types.Int64  `tfsdk:"character_count"`
+	IsDisabled     types.Bool   `tfsdk:"is_disabled"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (d *ServiceAccountKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account_keys"
+}
+
+func (d *ServiceAccountKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the API keys of a service account. Key secrets are only returned when a key is created.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the service account user.",
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The API keys of the service account.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"hint": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The visible part of the key.",
+						},
+						"permissions": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"character_limit": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Monthly character limit of the key, if any.",
+						},
+						"character_count": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Characters used by the key this month.",
+						},
+						"is_disabled": schema.BoolAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the key was created, in RFC 3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServiceAccountKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServiceAccountKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServiceAccountKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := d.client.GetServiceAccountAPIKeys(data.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading service account keys", err.Error())
+		return
+	}
+
+	data.Keys = make([]ServiceAccountKeyModel, len(keys))
+	for i, key := range keys {
+		permissions, diags := stringsToListValue(ctx, key.Permissions)
+		resp.Diagnostics.Append(diags...)
+
+		model := ServiceAccountKeyModel{
+			KeyID:          types.StringValue(key.KeyID),
+			Name:           types.StringValue(key.Name),
+			Hint:           optionalStringValue(key.Hint),
+			Permissions:    permissions,
+			CharacterLimit: types.Int64Null(),
+			CharacterCount: types.Int64Null(),
+			IsDisabled:     types.BoolValue(key.IsDisabled),
+			CreatedAt:      types.StringNull(),
+		}
+		if key.CharacterLimit != nil {
+			model.CharacterLimit = types.Int64Value(int64(*key.CharacterLimit))
+		}
+		if key.CharacterCount != nil {
+			model.CharacterCount = types.Int64Value(int64(*key.CharacterCount))
+		}
+		if key.CreatedAtUnix > 0 {
+			model.CreatedAt = types.StringValue(time.Unix(key.CreatedAtUnix, 0).UTC().Format(time.RFC3339))
+		}
+		data.Keys[i] = model
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
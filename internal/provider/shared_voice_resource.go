@@ -32,6 +32,7 @@ type SharedVoiceResourceModel struct {
 	PublicUserID types.String `tfsdk:"public_user_id"`
 	VoiceID      types.String `tfsdk:"voice_id"`
 	Name         types.String `tfsdk:"name"`
+	Workspace    types.String `tfsdk:"workspace"`
 }
 
 func (r *SharedVoiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -67,6 +68,7 @@ func (r *SharedVoiceResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 				MarkdownDescription: "The name to give the voice in your collection.",
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -95,7 +97,13 @@ func (r *SharedVoiceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	voiceID, err := r.client.AddSharedVoice(data.PublicUserID.ValueString(), data.VoiceID.ValueString(), data.Name.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	voiceID, err := c.AddSharedVoice(data.PublicUserID.ValueString(), data.VoiceID.ValueString(), data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error adding shared voice", err.Error())
 		return
@@ -113,7 +121,13 @@ func (r *SharedVoiceResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	voice, err := r.client.GetVoice(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	voice, err := c.GetVoice(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading shared voice", err.Error())
 		return
@@ -135,7 +149,13 @@ func (r *SharedVoiceResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.client.DeleteVoice(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteVoice(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting shared voice from collection", err.Error())
 		return
@@ -143,5 +163,5 @@ func (r *SharedVoiceResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *SharedVoiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
 }
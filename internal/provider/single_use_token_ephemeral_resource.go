@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &SingleUseTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &SingleUseTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &SingleUseTokenEphemeralResource{}
+)
+
+var singleUseTokenTypes = []string{"realtime_scribe", "tts_websocket"}
+
+func NewSingleUseTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &SingleUseTokenEphemeralResource{}
+}
+
+type SingleUseTokenEphemeralResource struct {
+	client *client.Client
+}
+
+type SingleUseTokenEphemeralResourceModel struct {
+	TokenType types.String `tfsdk:"token_type"`
+	Token     types.String `tfsdk:"token"`
+	Workspace types.String `tfsdk:"workspace"`
+}
+
+func (r *SingleUseTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_single_use_token"
+}
+
+func (r *SingleUseTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mints a single-use token for client-side realtime APIs without exposing the API key or storing the token in state. " +
+			"Tokens expire after 15 minutes and are consumed on use.",
+		Attributes: map[string]schema.Attribute{
+			"token_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Type of token to mint: `realtime_scribe` or `tts_websocket`.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The single-use token.",
+			},
+			"workspace": ephemeralWorkspaceAttribute(),
+		},
+	}
+}
+
+func (r *SingleUseTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SingleUseTokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var tokenType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token_type"), &tokenType)...)
+	if resp.Diagnostics.HasError() || tokenType.IsNull() || tokenType.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(singleUseTokenTypes, tokenType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_type"),
+			"Invalid Token Type",
+			fmt.Sprintf("token_type must be one of: %s.", strings.Join(singleUseTokenTypes, ", ")),
+		)
+	}
+}
+
+func (r *SingleUseTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SingleUseTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := c.CreateSingleUseToken(data.TokenType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating single-use token", err.Error())
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
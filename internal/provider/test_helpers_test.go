@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 type testRoute struct {
@@ -65,3 +71,56 @@ func writeTempFile(t *testing.T, name string, contents []byte) string {
 	}
 	return path
 }
+
+// validateResourceConfig runs a resource's ValidateConfig against a config
+// with the given attribute values; attributes that are not given are null.
+func validateResourceConfig(t *testing.T, r resource.Resource, values map[string]tftypes.Value) diag.Diagnostics {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+		} else {
+			attrs[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	validator, ok := r.(resource.ResourceWithValidateConfig)
+	if !ok {
+		t.Fatalf("%T does not implement ValidateConfig", r)
+	}
+
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attrs)},
+	}
+	var resp resource.ValidateConfigResponse
+	validator.ValidateConfig(ctx, req, &resp)
+	return resp.Diagnostics
+}
+
+// importResourceState runs r's ImportState for id against an empty state and
+// returns the resulting state.
+func importResourceState(t *testing.T, r resource.Resource, id string) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	importer, ok := r.(resource.ResourceWithImportState)
+	if !ok {
+		t.Fatalf("%T does not implement ImportState", r)
+	}
+
+	resp := resource.ImportStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	importer.ImportState(ctx, resource.ImportStateRequest{ID: id}, &resp)
+	return resp.State, resp.Diagnostics
+}
@@ -29,6 +29,7 @@ type VoiceResourceModel struct {
 	Labels      types.Map      `tfsdk:"labels"`
 	Files       types.List     `tfsdk:"files"`
 	Settings    *VoiceSettings `tfsdk:"settings"`
+	Workspace   types.String   `tfsdk:"workspace"`
 }
 
 type VoiceSettings struct {
@@ -101,6 +102,7 @@ func (r *VoiceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 				},
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -129,6 +131,12 @@ func (r *VoiceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var files []string
 	resp.Diagnostics.Append(data.Files.ElementsAs(ctx, &files, false)...)
 	if resp.Diagnostics.HasError() {
@@ -148,7 +156,7 @@ func (r *VoiceResource) Create(ctx context.Context, req resource.CreateRequest,
 		Files:       files,
 	}
 
-	voice, err := r.client.AddVoice(addReq)
+	voice, err := c.AddVoice(addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating voice", err.Error())
 		return
@@ -161,7 +169,7 @@ func (r *VoiceResource) Create(ctx context.Context, req resource.CreateRequest,
 			Style:           data.Settings.Style.ValueFloat64(),
 			UseSpeakerBoost: data.Settings.UseSpeakerBoost.ValueBool(),
 		}
-		err = r.client.EditVoiceSettings(voice.VoiceID, settingsReq)
+		err = c.EditVoiceSettings(voice.VoiceID, settingsReq)
 		if err != nil {
 			resp.Diagnostics.AddError("Error setting voice settings", err.Error())
 			return
@@ -180,7 +188,13 @@ func (r *VoiceResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	voice, err := r.client.GetVoice(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	voice, err := c.GetVoice(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading voice", err.Error())
 		return
@@ -212,6 +226,12 @@ func (r *VoiceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var files []string
 	resp.Diagnostics.Append(data.Files.ElementsAs(ctx, &files, false)...)
 	if resp.Diagnostics.HasError() {
@@ -231,7 +251,7 @@ func (r *VoiceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Files:       files,
 	}
 
-	err := r.client.EditVoice(data.ID.ValueString(), updateReq)
+	err := c.EditVoice(data.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating voice", err.Error())
 		return
@@ -244,7 +264,7 @@ func (r *VoiceResource) Update(ctx context.Context, req resource.UpdateRequest,
 			Style:           data.Settings.Style.ValueFloat64(),
 			UseSpeakerBoost: data.Settings.UseSpeakerBoost.ValueBool(),
 		}
-		err = r.client.EditVoiceSettings(data.ID.ValueString(), settingsReq)
+		err = c.EditVoiceSettings(data.ID.ValueString(), settingsReq)
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating voice settings", err.Error())
 			return
@@ -261,7 +281,13 @@ func (r *VoiceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteVoice(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteVoice(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting voice", err.Error())
 		return
@@ -269,5 +295,5 @@ func (r *VoiceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *VoiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
 }
@@ -29,10 +29,11 @@ type VoiceSampleResource struct {
 }
 
 type VoiceSampleResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	VoiceID  types.String `tfsdk:"voice_id"`
-	FilePath types.String `tfsdk:"file_path"`
-	FileName types.String `tfsdk:"file_name"`
+	ID        types.String `tfsdk:"id"`
+	VoiceID   types.String `tfsdk:"voice_id"`
+	FilePath  types.String `tfsdk:"file_path"`
+	FileName  types.String `tfsdk:"file_name"`
+	Workspace types.String `tfsdk:"workspace"`
 }
 
 func (r *VoiceSampleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,6 +65,7 @@ func (r *VoiceSampleResource) Schema(ctx context.Context, req resource.SchemaReq
 			"file_name": schema.StringAttribute{
 				Computed: true,
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -92,11 +94,17 @@ func (r *VoiceSampleResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq := &models.AddVoiceSampleRequest{
 		FilePath: data.FilePath.ValueString(),
 	}
 
-	sample, err := r.client.AddVoiceSample(data.VoiceID.ValueString(), addReq)
+	sample, err := c.AddVoiceSample(data.VoiceID.ValueString(), addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error adding voice sample", err.Error())
 		return
@@ -123,7 +131,13 @@ func (r *VoiceSampleResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.client.DeleteVoiceSample(data.VoiceID.ValueString(), data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteVoiceSample(data.VoiceID.ValueString(), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting voice sample", err.Error())
 		return
@@ -131,5 +145,5 @@ func (r *VoiceSampleResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *VoiceSampleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
 }
@@ -23,8 +23,9 @@ type WorkspaceGroupMemberResource struct {
 }
 
 type WorkspaceGroupMemberResourceModel struct {
-	GroupID types.String `tfsdk:"group_id"`
-	Email   types.String `tfsdk:"email"`
+	GroupID   types.String `tfsdk:"group_id"`
+	Email     types.String `tfsdk:"email"`
+	Workspace types.String `tfsdk:"workspace"`
 }
 
 func (r *WorkspaceGroupMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,6 +50,7 @@ func (r *WorkspaceGroupMemberResource) Schema(ctx context.Context, req resource.
 				},
 				MarkdownDescription: "Email of the user to add as a group member.",
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -77,7 +79,13 @@ func (r *WorkspaceGroupMemberResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	err := r.client.AddWorkspaceGroupMember(data.GroupID.ValueString(), data.Email.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.AddWorkspaceGroupMember(data.GroupID.ValueString(), data.Email.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error adding group member", err.Error())
 		return
@@ -93,7 +101,13 @@ func (r *WorkspaceGroupMemberResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	groups, err := r.client.SearchWorkspaceGroups("")
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := c.SearchWorkspaceGroups("")
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading group membership", err.Error())
 		return
@@ -125,7 +139,13 @@ func (r *WorkspaceGroupMemberResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	err := r.client.RemoveWorkspaceGroupMember(data.GroupID.ValueString(), data.Email.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.RemoveWorkspaceGroupMember(data.GroupID.ValueString(), data.Email.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error removing group member", err.Error())
 		return
@@ -133,7 +153,8 @@ func (r *WorkspaceGroupMemberResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *WorkspaceGroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "/")
+	id := importStateWorkspace(ctx, req.ID, 2, resp)
+	parts := strings.Split(id, "/")
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError("Invalid import ID", "Expected format: group_id/email")
 		return
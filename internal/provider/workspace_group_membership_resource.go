@@ -28,9 +28,10 @@ type WorkspaceGroupMembershipResource struct {
 }
 
 type WorkspaceGroupMembershipResourceModel struct {
-	ID      types.String `tfsdk:"id"`
-	GroupID types.String `tfsdk:"group_id"`
-	Email   types.String `tfsdk:"email"`
+	ID        types.String `tfsdk:"id"`
+	GroupID   types.String `tfsdk:"group_id"`
+	Email     types.String `tfsdk:"email"`
+	Workspace types.String `tfsdk:"workspace"`
 }
 
 func (r *WorkspaceGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,6 +60,7 @@ func (r *WorkspaceGroupMembershipResource) Schema(ctx context.Context, req resou
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -87,7 +89,13 @@ func (r *WorkspaceGroupMembershipResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	err := r.client.AddWorkspaceGroupMember(data.GroupID.ValueString(), data.Email.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.AddWorkspaceGroupMember(data.GroupID.ValueString(), data.Email.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error adding group member", err.Error())
 		return
@@ -113,7 +121,13 @@ func (r *WorkspaceGroupMembershipResource) Delete(ctx context.Context, req resou
 		return
 	}
 
-	err := r.client.RemoveWorkspaceGroupMember(data.GroupID.ValueString(), data.Email.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.RemoveWorkspaceGroupMember(data.GroupID.ValueString(), data.Email.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error removing group member", err.Error())
 		return
@@ -121,5 +135,5 @@ func (r *WorkspaceGroupMembershipResource) Delete(ctx context.Context, req resou
 }
 
 func (r *WorkspaceGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
 }
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+var (
+	_ resource.Resource                = &WorkspaceGroupResource{}
+	_ resource.ResourceWithConfigure   = &WorkspaceGroupResource{}
+	_ resource.ResourceWithImportState = &WorkspaceGroupResource{}
+)
+
+func NewWorkspaceGroupResource() resource.Resource {
+	return &WorkspaceGroupResource{}
+}
+
+type WorkspaceGroupResource struct {
+	client *client.Client
+}
+
+type WorkspaceGroupResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	MemberEmails    types.Set    `tfsdk:"member_emails"`
+	Authoritative   types.Bool   `tfsdk:"authoritative"`
+	AllMemberEmails types.Set    `tfsdk:"all_member_emails"`
+	Workspace       types.String `tfsdk:"workspace"`
+}
+
+func (r *WorkspaceGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_group"
+}
+
+func (r *WorkspaceGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the membership of an existing ElevenLabs workspace group. " +
+			"Groups cannot be created or deleted through the API, so the group is looked up by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the workspace group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The exact name of the workspace group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_emails": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Emails of the workspace members managed by Terraform.",
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				MarkdownDescription: "When `true`, members not listed in `member_emails` are removed from the group. " +
+					"Set to `false` to leave members added by SCIM or other tools untouched. Defaults to `true`.",
+			},
+			"all_member_emails": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Emails of every member of the group, including members not managed by Terraform.",
+			},
+			"workspace": workspaceAttribute(),
+		},
+	}
+}
+
+func (r *WorkspaceGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkspaceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkspaceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := c.SearchWorkspaceGroups(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error searching workspace groups", err.Error())
+		return
+	}
+
+	var group *models.WorkspaceGroup
+	for i := range groups {
+		if groups[i].Name == data.Name.ValueString() {
+			group = &groups[i]
+			break
+		}
+	}
+	if group == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Workspace Group Not Found",
+			fmt.Sprintf("No workspace group named %q exists. Groups must be created in the ElevenLabs dashboard or through SCIM.", data.Name.ValueString()))
+		return
+	}
+	data.ID = types.StringValue(group.GroupID)
+
+	resp.Diagnostics.Append(r.syncMembers(ctx, c, &data, group.MembersEmails, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkspaceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := findWorkspaceGroup(c, data.Name.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace group", err.Error())
+		return
+	}
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(group.GroupID)
+	data.Name = types.StringValue(group.Name)
+	if data.Authoritative.IsNull() {
+		data.Authoritative = types.BoolValue(true)
+	}
+
+	members := group.MembersEmails
+	if !data.Authoritative.ValueBool() && !data.MemberEmails.IsNull() {
+		var managed []string
+		resp.Diagnostics.Append(data.MemberEmails.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Only report the managed members that are still present so that
+		// removals made outside Terraform show up as drift.
+		members = intersectEmails(managed, group.MembersEmails)
+	}
+
+	memberEmails, diags := types.SetValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+	allMemberEmails, diags := types.SetValueFrom(ctx, types.StringType, group.MembersEmails)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.MemberEmails = memberEmails
+	data.AllMemberEmails = allMemberEmails
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state WorkspaceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := findWorkspaceGroup(c, state.Name.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace group", err.Error())
+		return
+	}
+	if group == nil {
+		resp.Diagnostics.AddError("Workspace Group Not Found", fmt.Sprintf("Workspace group %s no longer exists.", state.ID.ValueString()))
+		return
+	}
+
+	// Read records every member of an authoritative group, so after switching
+	// to non-authoritative mode the state no longer says which members
+	// Terraform managed. Nothing is removed on that transition rather than
+	// removing members added by SCIM or other tools.
+	var previous []string
+	if !state.Authoritative.ValueBool() || data.Authoritative.ValueBool() {
+		resp.Diagnostics.Append(state.MemberEmails.ElementsAs(ctx, &previous, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.syncMembers(ctx, c, &data, group.MembersEmails, previous)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkspaceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := findWorkspaceGroup(c, data.Name.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace group", err.Error())
+		return
+	}
+	if group == nil {
+		return
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(data.MemberEmails.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The group itself cannot be deleted, so only the managed members are removed.
+	for _, email := range intersectEmails(managed, group.MembersEmails) {
+		if err := c.RemoveWorkspaceGroupMember(data.ID.ValueString(), email); err != nil {
+			resp.Diagnostics.AddError("Error removing group member", fmt.Sprintf("Could not remove %s: %s", email, err))
+			return
+		}
+	}
+}
+
+// ImportState takes the group name, since groups can only be looked up by
+// name. Read then fills in the ID.
+func (r *WorkspaceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughWorkspaceID(ctx, path.Root("name"), req, resp)
+}
+
+// syncMembers adds and removes group members so that the group matches the
+// planned member_emails, then records the resulting membership.
+func (r *WorkspaceGroupResource) syncMembers(ctx context.Context, c *client.Client, data *WorkspaceGroupResourceModel, current, previous []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var desired []string
+	diags.Append(data.MemberEmails.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	add, remove := diffWorkspaceGroupMembers(current, desired, previous, data.Authoritative.ValueBool())
+	groupID := data.ID.ValueString()
+
+	for _, email := range remove {
+		if err := c.RemoveWorkspaceGroupMember(groupID, email); err != nil {
+			diags.AddError("Error removing group member", fmt.Sprintf("Could not remove %s: %s", email, err))
+			return diags
+		}
+	}
+	for _, email := range add {
+		if err := c.AddWorkspaceGroupMember(groupID, email); err != nil {
+			diags.AddError("Error adding group member", fmt.Sprintf("Could not add %s: %s", email, err))
+			return diags
+		}
+	}
+
+	all := append(subtractEmails(current, remove), add...)
+	sort.Strings(all)
+	allMemberEmails, d := types.SetValueFrom(ctx, types.StringType, all)
+	diags.Append(d...)
+	data.AllMemberEmails = allMemberEmails
+
+	return diags
+}
+
+// diffWorkspaceGroupMembers returns the emails to add and remove. In
+// authoritative mode every member that is not desired is removed; otherwise
+// only members that were previously managed by Terraform are removed.
+func diffWorkspaceGroupMembers(current, desired, previous []string, authoritative bool) (add, remove []string) {
+	add = subtractEmails(desired, current)
+
+	if authoritative {
+		remove = subtractEmails(current, desired)
+	} else {
+		remove = subtractEmails(intersectEmails(previous, current), desired)
+	}
+
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
+
+// findWorkspaceGroup searches for the group by name, which the API requires,
+// and matches on groupID. Without a groupID, as on import, it matches the
+// exact name instead.
+func findWorkspaceGroup(c *client.Client, name, groupID string) (*models.WorkspaceGroup, error) {
+	groups, err := c.SearchWorkspaceGroups(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range groups {
+		if groupID != "" && groups[i].GroupID == groupID {
+			return &groups[i], nil
+		}
+		if groupID == "" && groups[i].Name == name {
+			return &groups[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// subtractEmails returns the emails in a that are not in b.
+func subtractEmails(a, b []string) []string {
+	exclude := make(map[string]bool, len(b))
+	for _, email := range b {
+		exclude[email] = true
+	}
+
+	result := make([]string, 0, len(a))
+	for _, email := range a {
+		if !exclude[email] {
+			result = append(result, email)
+		}
+	}
+	return result
+}
+
+// intersectEmails returns the emails in a that are also in b.
+func intersectEmails(a, b []string) []string {
+	return subtractEmails(a, subtractEmails(a, b))
+}
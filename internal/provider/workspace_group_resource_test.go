@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+type testWorkspaceGroup struct {
+	mu      sync.Mutex
+	members map[string]bool
+}
+
+func (g *testWorkspaceGroup) emails() []string {
+	emails := make([]string, 0, len(g.members))
+	for email := range g.members {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+	return emails
+}
+
+func (g *testWorkspaceGroup) routes() []testRoute {
+	memberHandler := func(add bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Email string `json:"email"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+
+			g.mu.Lock()
+			if add {
+				g.members[body.Email] = true
+			} else {
+				delete(g.members, body.Email)
+			}
+			g.mu.Unlock()
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"ok"}`))
+		}
+	}
+
+	return []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/groups/search",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				name := r.URL.Query().Get("name")
+				if name == "" {
+					http.Error(w, `{"detail":"name is required"}`, http.StatusUnprocessableEntity)
+					return
+				}
+				g.mu.Lock()
+				defer g.mu.Unlock()
+				groups := []models.WorkspaceGroup{}
+				if strings.Contains("Support", name) {
+					groups = append(groups, models.WorkspaceGroup{GroupID: "group-123", Name: "Support", MembersEmails: g.emails()})
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(groups)
+			},
+		},
+		{
+			Method:  httpMethodPost,
+			Path:    "/workspace/groups/group-123/members",
+			Handler: memberHandler(true),
+		},
+		{
+			Method:  httpMethodPost,
+			Path:    "/workspace/groups/group-123/members/remove",
+			Handler: memberHandler(false),
+		},
+	}
+}
+
+func (g *testWorkspaceGroup) check(expected ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		if got := strings.Join(g.emails(), ","); got != strings.Join(expected, ",") {
+			return fmt.Errorf("expected group members %v, got %s", expected, got)
+		}
+		return nil
+	}
+}
+
+func TestAccWorkspaceGroupResource(t *testing.T) {
+	group := &testWorkspaceGroup{
+		members: map[string]bool{"scim@example.com": true},
+	}
+
+	server := newTestServer(t, group.routes())
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_workspace_group" "support" {
+  name          = "Support"
+  authoritative = false
+  member_emails = ["alice@example.com", "bob@example.com"]
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_workspace_group.support", "id", "group-123"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_group.support", "member_emails.#", "2"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_group.support", "all_member_emails.#", "3"),
+					group.check("alice@example.com", "bob@example.com", "scim@example.com"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_workspace_group" "support" {
+  name          = "Support"
+  authoritative = false
+  member_emails = ["alice@example.com"]
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_workspace_group.support", "member_emails.#", "1"),
+					group.check("alice@example.com", "scim@example.com"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_workspace_group" "support" {
+  name          = "Support"
+  member_emails = ["alice@example.com", "carol@example.com"]
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_workspace_group.support", "authoritative", "true"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_group.support", "all_member_emails.#", "2"),
+					group.check("alice@example.com", "carol@example.com"),
+				),
+			},
+			{
+				ResourceName:            "elevenlabs_workspace_group.support",
+				ImportState:             true,
+				ImportStateId:           "Support",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"workspace"},
+			},
+		},
+	})
+}
+
+func TestAccWorkspaceGroupResource_LeaveAuthoritative(t *testing.T) {
+	group := &testWorkspaceGroup{
+		members: map[string]bool{},
+	}
+
+	server := newTestServer(t, group.routes())
+	defer server.Close()
+
+	config := func(authoritative bool) string {
+		return fmt.Sprintf(`
+%s
+
+resource "elevenlabs_workspace_group" "support" {
+  name          = "Support"
+  authoritative = %t
+  member_emails = ["alice@example.com"]
+}
+`, testAccProviderConfig(server.URL), authoritative)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check:  group.check("alice@example.com"),
+			},
+			{
+				// A member added by SCIM is kept once the group stops being
+				// authoritative, even though the refreshed state lists it.
+				PreConfig: func() {
+					group.mu.Lock()
+					group.members["scim@example.com"] = true
+					group.mu.Unlock()
+				},
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_workspace_group.support", "authoritative", "false"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_group.support", "member_emails.#", "1"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_group.support", "all_member_emails.#", "2"),
+					group.check("alice@example.com", "scim@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestDiffWorkspaceGroupMembers(t *testing.T) {
+	current := []string{"alice@example.com", "bob@example.com", "scim@example.com"}
+	desired := []string{"alice@example.com", "carol@example.com"}
+	previous := []string{"alice@example.com", "bob@example.com"}
+
+	add, remove := diffWorkspaceGroupMembers(current, desired, previous, true)
+	if got := strings.Join(add, ","); got != "carol@example.com" {
+		t.Errorf("Expected additions 'carol@example.com', got '%s'", got)
+	}
+	if got := strings.Join(remove, ","); got != "bob@example.com,scim@example.com" {
+		t.Errorf("Expected authoritative removals 'bob@example.com,scim@example.com', got '%s'", got)
+	}
+
+	add, remove = diffWorkspaceGroupMembers(current, desired, previous, false)
+	if got := strings.Join(add, ","); got != "carol@example.com" {
+		t.Errorf("Expected additions 'carol@example.com', got '%s'", got)
+	}
+	if got := strings.Join(remove, ","); got != "bob@example.com" {
+		t.Errorf("Expected non-authoritative removals 'bob@example.com', got '%s'", got)
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+)
+
+func workspaceAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "Name of a workspace from the provider `workspaces` map to manage this resource in. Defaults to the workspace of the provider `api_key`.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func ephemeralWorkspaceAttribute() ephemeralschema.StringAttribute {
+	return ephemeralschema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "Name of a workspace from the provider `workspaces` map to open this resource in. Defaults to the workspace of the provider `api_key`.",
+	}
+}
+
+func clientForWorkspace(c *client.Client, workspace types.String) (*client.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	wc, err := c.Workspace(workspace.ValueString())
+	if err != nil {
+		diags.AddError("Unknown ElevenLabs workspace", err.Error())
+		return nil, diags
+	}
+
+	return wc, diags
+}
+
+// importStateWorkspace strips an optional "<workspace>/" prefix from an import
+// ID, records the workspace in state and returns the rest of the ID. fields is
+// the number of "/"-separated fields in the resource's own import ID, so a
+// prefix is only recognised when the ID has one more field than that. An empty
+// prefix ("/<id>") selects the default workspace, which allows importing IDs
+// that contain "/" themselves.
+func importStateWorkspace(ctx context.Context, id string, fields int, resp *resource.ImportStateResponse) string {
+	parts := strings.SplitN(id, "/", fields+1)
+	if len(parts) <= fields {
+		return id
+	}
+
+	if parts[0] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), parts[0])...)
+	}
+	return id[len(parts[0])+1:]
+}
+
+// importStatePassthroughWorkspaceID is resource.ImportStatePassthroughID for
+// resources with a workspace attribute; the import ID is [<workspace>/]<id>.
+func importStatePassthroughWorkspaceID(ctx context.Context, attrPath path.Path, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importStateWorkspace(ctx, req.ID, 1, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
+}
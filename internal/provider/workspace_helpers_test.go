@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestImportStateWorkspace(t *testing.T) {
+	tests := []struct {
+		name      string
+		resource  resource.Resource
+		id        string
+		idPath    string
+		expected  string
+		workspace string
+	}{
+		{
+			name:     "plain ID",
+			resource: NewWorkspaceWebhookResource(),
+			id:       "webhook-123",
+			idPath:   "id",
+			expected: "webhook-123",
+		},
+		{
+			name:      "workspace prefix",
+			resource:  NewWorkspaceWebhookResource(),
+			id:        "shared/webhook-123",
+			idPath:    "id",
+			expected:  "webhook-123",
+			workspace: "shared",
+		},
+		{
+			name:     "empty prefix keeps a slash in the ID",
+			resource: NewWorkspaceGroupResource(),
+			id:       "/Sales/EMEA",
+			idPath:   "name",
+			expected: "Sales/EMEA",
+		},
+		{
+			name:     "two field ID",
+			resource: NewServiceAccountKeyResource(),
+			id:       "user-123/key-123",
+			idPath:   "user_id",
+			expected: "user-123",
+		},
+		{
+			name:      "two field ID with workspace prefix",
+			resource:  NewServiceAccountKeyResource(),
+			id:        "shared/user-123/key-123",
+			idPath:    "user_id",
+			expected:  "user-123",
+			workspace: "shared",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, diags := importResourceState(t, tt.resource, tt.id)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var id, workspace types.String
+			state.GetAttribute(context.Background(), path.Root(tt.idPath), &id)
+			state.GetAttribute(context.Background(), path.Root("workspace"), &workspace)
+			if id.ValueString() != tt.expected {
+				t.Errorf("expected %s %q, got %s", tt.idPath, tt.expected, id)
+			}
+			if tt.workspace == "" && !workspace.IsNull() {
+				t.Errorf("expected no workspace, got %s", workspace)
+			}
+			if tt.workspace != "" && workspace.ValueString() != tt.workspace {
+				t.Errorf("expected workspace %q, got %s", tt.workspace, workspace)
+			}
+		})
+	}
+}
@@ -29,8 +29,9 @@ type WorkspaceInviteResource struct {
 }
 
 type WorkspaceInviteResourceModel struct {
-	Email types.String `tfsdk:"email"`
-	Role  types.String `tfsdk:"role"`
+	Email     types.String `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	Workspace types.String `tfsdk:"workspace"`
 }
 
 func (r *WorkspaceInviteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +52,7 @@ func (r *WorkspaceInviteResource) Schema(ctx context.Context, req resource.Schem
 				Required:            true,
 				MarkdownDescription: "Permission level for the user. e.g., `workspace_member`, `workspace_admin`, `admin`.",
 			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -79,12 +81,18 @@ func (r *WorkspaceInviteResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addReq := &models.CreateWorkspaceInviteRequest{
 		Email:               data.Email.ValueString(),
 		WorkspacePermission: data.Role.ValueString(),
 	}
 
-	err := r.client.CreateWorkspaceInvite(addReq)
+	err := c.CreateWorkspaceInvite(addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating workspace invite", err.Error())
 		return
@@ -110,7 +118,13 @@ func (r *WorkspaceInviteResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := r.client.DeleteWorkspaceInvite(data.Email.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteWorkspaceInvite(data.Email.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting workspace invite", err.Error())
 		return
@@ -118,5 +132,5 @@ func (r *WorkspaceInviteResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *WorkspaceInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("email"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("email"), req, resp)
 }
@@ -0,0 +1,395 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+var (
+	_ resource.Resource              = &WorkspaceInvitesResource{}
+	_ resource.ResourceWithConfigure = &WorkspaceInvitesResource{}
+)
+
+func NewWorkspaceInvitesResource() resource.Resource {
+	return &WorkspaceInvitesResource{}
+}
+
+type WorkspaceInvitesResource struct {
+	client *client.Client
+}
+
+type WorkspaceInvitesResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Emails              types.Set    `tfsdk:"emails"`
+	WorkspacePermission types.String `tfsdk:"workspace_permission"`
+	GroupIDs            types.Set    `tfsdk:"group_ids"`
+	PendingEmails       types.Set    `tfsdk:"pending_emails"`
+	AcceptedEmails      types.Set    `tfsdk:"accepted_emails"`
+	Workspace           types.String `tfsdk:"workspace"`
+}
+
+func (r *WorkspaceInvitesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_invites"
+}
+
+func (r *WorkspaceInvitesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invites a set of users to the workspace. Invites that are accepted are kept in `emails` without changes, " +
+			"so the accepted users can be managed with `elevenlabs_workspace_member`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"emails": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Emails of the users to invite.",
+			},
+			"workspace_permission": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Permission granted to the invited users, e.g. `workspace_member` or `workspace_admin`. " +
+					"When unset, the bulk invite endpoint is used and the API default applies. Changing this forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the workspace groups the invited users join. Changing this forces a new resource.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"pending_emails": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Emails whose invites have not been accepted yet.",
+			},
+			"accepted_emails": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Emails that have accepted their invite and are now workspace members.",
+			},
+			"workspace": workspaceAttribute(),
+		},
+	}
+}
+
+func (r *WorkspaceInvitesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkspaceInvitesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkspaceInvitesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var emails []string
+	resp.Diagnostics.Append(data.Emails.ElementsAs(ctx, &emails, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.invite(ctx, c, &data, emails)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The invited emails change over the resource's life, so the ID is random
+	// rather than derived from them.
+	id, err := newRandomID()
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating ID", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(r.refresh(ctx, c, &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceInvitesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkspaceInvitesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, c, &data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceInvitesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state WorkspaceInvitesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired, previous []string
+	resp.Diagnostics.Append(data.Emails.ElementsAs(ctx, &desired, false)...)
+	resp.Diagnostics.Append(state.Emails.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pending, err := pendingWorkspaceInvites(c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace invites", err.Error())
+		return
+	}
+
+	// Accepted invites cannot be revoked; those users are handed off to
+	// elevenlabs_workspace_member.
+	for _, email := range subtractEmails(previous, desired) {
+		if !pending[strings.ToLower(email)] {
+			continue
+		}
+		if err := c.DeleteWorkspaceInvite(email); err != nil {
+			resp.Diagnostics.AddError("Error deleting workspace invite", fmt.Sprintf("Could not revoke the invite for %s: %s", email, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.invite(ctx, c, &data, subtractEmails(desired, previous))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, c, &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceInvitesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkspaceInvitesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var emails []string
+	resp.Diagnostics.Append(data.Emails.ElementsAs(ctx, &emails, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pending, err := pendingWorkspaceInvites(c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace invites", err.Error())
+		return
+	}
+
+	for _, email := range emails {
+		if !pending[strings.ToLower(email)] {
+			continue
+		}
+		if err := c.DeleteWorkspaceInvite(email); err != nil {
+			resp.Diagnostics.AddError("Error deleting workspace invite", fmt.Sprintf("Could not revoke the invite for %s: %s", email, err))
+			return
+		}
+	}
+}
+
+// invite sends invites to the given emails, skipping users that are already
+// workspace members. The bulk endpoint does not accept a permission, so
+// invites with a workspace_permission are sent one at a time.
+func (r *WorkspaceInvitesResource) invite(ctx context.Context, c *client.Client, data *WorkspaceInvitesResourceModel, emails []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	members, err := workspaceMemberEmails(c)
+	if err != nil {
+		diags.AddError("Error reading workspace members", err.Error())
+		return diags
+	}
+
+	toInvite := make([]string, 0, len(emails))
+	for _, email := range emails {
+		if !members[strings.ToLower(email)] {
+			toInvite = append(toInvite, email)
+		}
+	}
+	if len(toInvite) == 0 {
+		return diags
+	}
+	sort.Strings(toInvite)
+
+	var groupIDs []string
+	if !data.GroupIDs.IsNull() {
+		diags.Append(data.GroupIDs.ElementsAs(ctx, &groupIDs, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if data.WorkspacePermission.IsNull() {
+		err := c.CreateWorkspaceInvitesBulk(&models.CreateWorkspaceInvitesBulkRequest{
+			Emails:   toInvite,
+			GroupIDs: groupIDs,
+		})
+		if err != nil {
+			diags.AddError("Error creating workspace invites", err.Error())
+		}
+		return diags
+	}
+
+	for _, email := range toInvite {
+		err := c.CreateWorkspaceInvite(&models.CreateWorkspaceInviteRequest{
+			Email:               email,
+			WorkspacePermission: data.WorkspacePermission.ValueString(),
+			GroupIDs:            groupIDs,
+		})
+		if err != nil {
+			diags.AddError("Error creating workspace invite", fmt.Sprintf("Could not invite %s: %s", email, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// refresh sorts the managed emails into pending and accepted invites. When
+// prune is set, emails that are neither, because the invite was revoked or
+// declined, are dropped from emails so that the next plan sends them again.
+func (r *WorkspaceInvitesResource) refresh(ctx context.Context, c *client.Client, data *WorkspaceInvitesResourceModel, prune bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var emails []string
+	diags.Append(data.Emails.ElementsAs(ctx, &emails, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	pending, err := pendingWorkspaceInvites(c)
+	if err != nil {
+		diags.AddError("Error reading workspace invites", err.Error())
+		return diags
+	}
+
+	members, err := workspaceMemberEmails(c)
+	if err != nil {
+		diags.AddError("Error reading workspace members", err.Error())
+		return diags
+	}
+
+	var current, pendingEmails, acceptedEmails []string
+	for _, email := range emails {
+		switch {
+		case members[strings.ToLower(email)]:
+			acceptedEmails = append(acceptedEmails, email)
+		case pending[strings.ToLower(email)] || !prune:
+			pendingEmails = append(pendingEmails, email)
+		default:
+			continue
+		}
+		current = append(current, email)
+	}
+
+	var d diag.Diagnostics
+	data.Emails, d = types.SetValueFrom(ctx, types.StringType, current)
+	diags.Append(d...)
+	data.PendingEmails, d = types.SetValueFrom(ctx, types.StringType, pendingEmails)
+	diags.Append(d...)
+	data.AcceptedEmails, d = types.SetValueFrom(ctx, types.StringType, acceptedEmails)
+	diags.Append(d...)
+
+	return diags
+}
+
+// pendingWorkspaceInvites returns the lowercased emails of open invites.
+func pendingWorkspaceInvites(c *client.Client) (map[string]bool, error) {
+	invites, err := c.GetWorkspaceInvites()
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make(map[string]bool, len(invites))
+	for _, invite := range invites {
+		pending[strings.ToLower(invite.Email)] = true
+	}
+	return pending, nil
+}
+
+// workspaceMemberEmails returns the lowercased emails of members that have
+// joined the workspace.
+func workspaceMemberEmails(c *client.Client) (map[string]bool, error) {
+	members, err := c.GetWorkspaceMembers()
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make(map[string]bool, len(members))
+	for _, member := range members {
+		if !member.IsInvited {
+			emails[strings.ToLower(member.Email)] = true
+		}
+	}
+	return emails, nil
+}
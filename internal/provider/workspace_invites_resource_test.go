@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+type testWorkspaceInvites struct {
+	mu      sync.Mutex
+	invites map[string]string
+	members map[string]bool
+}
+
+func (w *testWorkspaceInvites) accept(email string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.invites, email)
+	w.members[email] = true
+}
+
+func (w *testWorkspaceInvites) revoke(email string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.invites, email)
+}
+
+func (w *testWorkspaceInvites) routes() []testRoute {
+	ok := func(rw http.ResponseWriter) {
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{"status":"ok"}`))
+	}
+
+	return []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/invites",
+			Handler: func(rw http.ResponseWriter, r *http.Request) {
+				w.mu.Lock()
+				defer w.mu.Unlock()
+				invites := make([]models.WorkspaceInvite, 0, len(w.invites))
+				for email, role := range w.invites {
+					invites = append(invites, models.WorkspaceInvite{Email: email, Role: role})
+				}
+				rw.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(rw).Encode(invites)
+			},
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/members",
+			Handler: func(rw http.ResponseWriter, r *http.Request) {
+				w.mu.Lock()
+				defer w.mu.Unlock()
+				members := make([]models.WorkspaceMember, 0, len(w.members))
+				for email := range w.members {
+					members = append(members, models.WorkspaceMember{UserID: "user-" + email, Email: email, WorkspacePermission: "workspace_member"})
+				}
+				rw.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(rw).Encode(members)
+			},
+		},
+		{
+			Method: httpMethodPost,
+			Path:   "/workspace/invites/add-bulk",
+			Handler: func(rw http.ResponseWriter, r *http.Request) {
+				var body models.CreateWorkspaceInvitesBulkRequest
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				w.mu.Lock()
+				for _, email := range body.Emails {
+					w.invites[email] = "workspace_member"
+				}
+				w.mu.Unlock()
+				ok(rw)
+			},
+		},
+		{
+			Method: httpMethodPost,
+			Path:   "/workspace/invites/add",
+			Handler: func(rw http.ResponseWriter, r *http.Request) {
+				var body models.CreateWorkspaceInviteRequest
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				w.mu.Lock()
+				w.invites[body.Email] = body.WorkspacePermission
+				w.mu.Unlock()
+				ok(rw)
+			},
+		},
+		{
+			Method: httpMethodDelete,
+			Path:   "/workspace/invites",
+			Handler: func(rw http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Email string `json:"email"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				w.revoke(body.Email)
+				ok(rw)
+			},
+		},
+	}
+}
+
+func (w *testWorkspaceInvites) check(expected ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		got := make([]string, 0, len(w.invites))
+		for email, role := range w.invites {
+			got = append(got, email+"="+role)
+		}
+		sort.Strings(got)
+		if strings.Join(got, ",") != strings.Join(expected, ",") {
+			return fmt.Errorf("expected pending invites %v, got %v", expected, got)
+		}
+		return nil
+	}
+}
+
+func TestAccWorkspaceInvitesResource(t *testing.T) {
+	invites := &testWorkspaceInvites{
+		invites: map[string]string{},
+		members: map[string]bool{"owner@example.com": true},
+	}
+
+	server := newTestServer(t, invites.routes())
+	defer server.Close()
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_workspace_invites" "team" {
+  emails = ["alice@example.com", "bob@example.com", "owner@example.com"]
+}
+
+resource "elevenlabs_workspace_invites" "admins" {
+  emails               = ["admin@example.com"]
+  workspace_permission = "workspace_admin"
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["elevenlabs_workspace_invites.team"].Primary.ID
+						return nil
+					},
+					resource.TestCheckResourceAttr("elevenlabs_workspace_invites.team", "emails.#", "3"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_invites.team", "pending_emails.#", "2"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_invites.team", "accepted_emails.#", "1"),
+					invites.check("admin@example.com=workspace_admin", "alice@example.com=workspace_member", "bob@example.com=workspace_member"),
+				),
+			},
+			{
+				PreConfig: func() {
+					invites.accept("alice@example.com")
+					invites.revoke("bob@example.com")
+				},
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_workspace_invites" "team" {
+  emails = ["alice@example.com", "bob@example.com", "owner@example.com", "carol@example.com"]
+}
+
+resource "elevenlabs_workspace_invites" "admins" {
+  emails               = ["admin@example.com"]
+  workspace_permission = "workspace_admin"
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("elevenlabs_workspace_invites.team", "id", func(value string) error {
+						if value != id {
+							return fmt.Errorf("expected the ID to stay %q across email changes, got %q", id, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_invites.team", "emails.#", "4"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_invites.team", "pending_emails.#", "2"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_invites.team", "accepted_emails.#", "2"),
+					invites.check("admin@example.com=workspace_admin", "bob@example.com=workspace_member", "carol@example.com=workspace_member"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_workspace_invites" "team" {
+  emails = ["bob@example.com"]
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_workspace_invites.team", "emails.#", "1"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_invites.team", "accepted_emails.#", "0"),
+					invites.check("bob@example.com=workspace_member"),
+				),
+			},
+		},
+	})
+}
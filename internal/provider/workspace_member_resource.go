@@ -3,10 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,9 +19,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &WorkspaceMemberResource{}
-	_ resource.ResourceWithConfigure   = &WorkspaceMemberResource{}
-	_ resource.ResourceWithImportState = &WorkspaceMemberResource{}
+	_ resource.Resource                   = &WorkspaceMemberResource{}
+	_ resource.ResourceWithConfigure      = &WorkspaceMemberResource{}
+	_ resource.ResourceWithImportState    = &WorkspaceMemberResource{}
+	_ resource.ResourceWithValidateConfig = &WorkspaceMemberResource{}
 )
 
 func NewWorkspaceMemberResource() resource.Resource {
@@ -32,6 +37,9 @@ type WorkspaceMemberResourceModel struct {
 	ID                  types.String `tfsdk:"id"`
 	Email               types.String `tfsdk:"email"`
 	WorkspacePermission types.String `tfsdk:"workspace_permission"`
+	IsLocked            types.Bool   `tfsdk:"is_locked"`
+	DeactivateOnDestroy types.Bool   `tfsdk:"deactivate_on_destroy"`
+	Workspace           types.String `tfsdk:"workspace"`
 }
 
 func (r *WorkspaceMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,22 +48,45 @@ func (r *WorkspaceMemberResource) Metadata(ctx context.Context, req resource.Met
 
 func (r *WorkspaceMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Workspace Member resource for ElevenLabs. Allows managing roles of existing workspace members.",
+		MarkdownDescription: "Workspace Member resource for ElevenLabs. Allows managing the role and lock status of existing workspace members.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
-				MarkdownDescription: "The User ID of the member.",
+				MarkdownDescription: "The User ID of the member. At least one of `id` or `email` must be set.",
 			},
 			"email": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The email of the member.",
 			},
 			"workspace_permission": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "Permission level for the user. e.g., `workspace_member`, `workspace_admin`, `admin`.",
 			},
+			"is_locked": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Whether the member's account is locked. Locked members cannot access the workspace.",
+			},
+			"deactivate_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Lock the member's account when the resource is destroyed. Defaults to `false`, which leaves the member untouched.",
+			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -77,58 +108,222 @@ func (r *WorkspaceMemberResource) Configure(ctx context.Context, req resource.Co
 	r.client = client
 }
 
+func (r *WorkspaceMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var id, email types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("email"), &email)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if id.IsNull() && email.IsNull() {
+		resp.Diagnostics.AddError("Invalid Configuration", "At least one of `id` or `email` must be set.")
+	}
+}
+
 func (r *WorkspaceMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	// Create for a member usually means updating an existing user's role in the workspace.
+	// Create for a member means taking over the role of an existing user in the workspace.
 	var data WorkspaceMemberResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateReq := &models.UpdateWorkspaceMemberRequest{
-		WorkspacePermission: data.WorkspacePermission.ValueString(),
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err := r.client.UpdateWorkspaceMember(data.ID.ValueString(), updateReq)
+	member, err := findWorkspaceMember(c, data.ID.ValueString(), data.Email.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating workspace member", err.Error())
+		resp.Diagnostics.AddError("Error reading workspace members", err.Error())
+		return
+	}
+	if member == nil {
+		resp.Diagnostics.AddError("Workspace Member Not Found",
+			"No workspace member matches the configured `id` or `email`. Invite the user with `elevenlabs_workspace_invites` first.")
+		return
+	}
+	if !data.ID.IsUnknown() && !data.Email.IsUnknown() && !strings.EqualFold(member.Email, data.Email.ValueString()) {
+		resp.Diagnostics.AddError("Invalid Configuration",
+			fmt.Sprintf("Workspace member %s has email %s, not %s.", member.UserID, member.Email, data.Email.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(c, member, &data, nil)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.Email = types.StringNull()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *WorkspaceMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// API Read logic
+	var data WorkspaceMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := findWorkspaceMember(c, data.ID.ValueString(), data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace members", err.Error())
+		return
+	}
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(member.UserID)
+	if !strings.EqualFold(data.Email.ValueString(), member.Email) {
+		data.Email = types.StringValue(member.Email)
+	}
+	data.WorkspacePermission = types.StringValue(member.WorkspacePermission)
+	data.IsLocked = types.BoolValue(member.IsLocked)
+	if data.DeactivateOnDestroy.IsNull() {
+		data.DeactivateOnDestroy = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *WorkspaceMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data WorkspaceMemberResourceModel
+	var data, state WorkspaceMemberResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateReq := &models.UpdateWorkspaceMemberRequest{
-		WorkspacePermission: data.WorkspacePermission.ValueString(),
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err := r.client.UpdateWorkspaceMember(data.ID.ValueString(), updateReq)
+	member, err := findWorkspaceMember(c, state.ID.ValueString(), state.Email.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating workspace member", err.Error())
+		resp.Diagnostics.AddError("Error reading workspace members", err.Error())
+		return
+	}
+	if member == nil {
+		resp.Diagnostics.AddError("Workspace Member Not Found", fmt.Sprintf("Workspace member %s no longer exists.", state.ID.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(c, member, &data, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.Email = types.StringNull()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *WorkspaceMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Deleting a member from workspace might require a different endpoint or just setting permission to none.
-	resp.Diagnostics.AddWarning("Delete not fully implemented", "Removing members from workspace is currently limited.")
+	var data WorkspaceMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API cannot remove members from a workspace, so offboarding locks the
+	// account instead. Without deactivate_on_destroy the member is left as is.
+	if !data.DeactivateOnDestroy.ValueBool() || data.IsLocked.ValueBool() {
+		return
+	}
+
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locked := true
+	err := c.UpdateWorkspaceMemberAccess(&models.UpdateWorkspaceMemberAccessRequest{
+		Email:    data.Email.ValueString(),
+		IsLocked: &locked,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error locking workspace member", err.Error())
+		return
+	}
 }
 
 func (r *WorkspaceMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if strings.Contains(req.ID, "@") {
+		importStatePassthroughWorkspaceID(ctx, path.Root("email"), req, resp)
+		return
+	}
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
+}
+
+// update applies the planned permission and lock status to the member and
+// records the resolved identity. state is nil on create.
+func (r *WorkspaceMemberResource) update(c *client.Client, member *models.WorkspaceMember, data, state *WorkspaceMemberResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state == nil || !data.WorkspacePermission.Equal(state.WorkspacePermission) {
+		updateReq := &models.UpdateWorkspaceMemberRequest{
+			WorkspacePermission: data.WorkspacePermission.ValueString(),
+		}
+
+		err := c.UpdateWorkspaceMember(member.UserID, updateReq)
+		if err != nil {
+			diags.AddError("Error updating workspace member", err.Error())
+			return diags
+		}
+	}
+
+	isLocked := member.IsLocked
+	if !data.IsLocked.IsUnknown() && data.IsLocked.ValueBool() != member.IsLocked {
+		isLocked = data.IsLocked.ValueBool()
+		err := c.UpdateWorkspaceMemberAccess(&models.UpdateWorkspaceMemberAccessRequest{
+			Email:    member.Email,
+			IsLocked: &isLocked,
+		})
+		if err != nil {
+			diags.AddError("Error updating workspace member lock status", err.Error())
+			return diags
+		}
+	}
+
+	data.ID = types.StringValue(member.UserID)
+	if data.Email.IsUnknown() {
+		data.Email = types.StringValue(member.Email)
+	}
+	data.IsLocked = types.BoolValue(isLocked)
+
+	return diags
+}
+
+// findWorkspaceMember looks up a member by user ID, falling back to a
+// case-insensitive email match when no ID is known.
+func findWorkspaceMember(c *client.Client, userID, email string) (*models.WorkspaceMember, error) {
+	members, err := c.GetWorkspaceMembers()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range members {
+		if userID != "" && members[i].UserID == userID {
+			return &members[i], nil
+		}
+	}
+	if email == "" {
+		return nil, nil
+	}
+	for i := range members {
+		if strings.EqualFold(members[i].Email, email) {
+			return &members[i], nil
+		}
+	}
+	return nil, nil
 }
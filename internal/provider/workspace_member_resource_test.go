@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+func TestAccWorkspaceMemberResource(t *testing.T) {
+	var mu sync.Mutex
+	member := models.WorkspaceMember{
+		UserID:              "user-456",
+		Email:               "alice@example.com",
+		WorkspacePermission: "workspace_member",
+	}
+
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/members",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode([]models.WorkspaceMember{member})
+			},
+		},
+		{
+			Method: httpMethodPost,
+			Path:   "/workspace/members/user-456",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body models.UpdateWorkspaceMemberRequest
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				mu.Lock()
+				member.WorkspacePermission = body.WorkspacePermission
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok"}`))
+			},
+		},
+		{
+			Method: httpMethodPost,
+			Path:   "/workspace/members",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body models.UpdateWorkspaceMemberAccessRequest
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				mu.Lock()
+				if body.Email == member.Email && body.IsLocked != nil {
+					member.IsLocked = *body.IsLocked
+				}
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok"}`))
+			},
+		},
+	})
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if !member.IsLocked {
+				return fmt.Errorf("expected member to be locked on destroy")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_workspace_member" "alice" {
+  email                = "alice@example.com"
+  workspace_permission = "workspace_admin"
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_workspace_member.alice", "id", "user-456"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_member.alice", "workspace_permission", "workspace_admin"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_member.alice", "is_locked", "false"),
+				),
+			},
+			{
+				ResourceName:      "elevenlabs_workspace_member.alice",
+				ImportState:       true,
+				ImportStateId:     "alice@example.com",
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"workspace",
+				},
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "elevenlabs_workspace_member" "alice" {
+  email                 = "alice@example.com"
+  workspace_permission  = "workspace_admin"
+  deactivate_on_destroy = true
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_workspace_member.alice", "deactivate_on_destroy", "true"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_member.alice", "is_locked", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkspaceMemberResource_ValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		id, email tftypes.Value
+		wantError bool
+	}{
+		"id":            {tftypes.NewValue(tftypes.String, "user-123"), tftypes.NewValue(tftypes.String, nil), false},
+		"email":         {tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, "alice@example.com"), false},
+		"unknown email": {tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, tftypes.UnknownValue), false},
+		"neither":       {tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, nil), true},
+	}
+
+	r := NewWorkspaceMemberResource()
+	for name, tt := range tests {
+		diags := validateResourceConfig(t, r, map[string]tftypes.Value{
+			"id":                   tt.id,
+			"email":                tt.email,
+			"workspace_permission": tftypes.NewValue(tftypes.String, "workspace_member"),
+		})
+		if diags.HasError() != tt.wantError {
+			t.Errorf("%s: expected error %t, got %v", name, tt.wantError, diags)
+		}
+	}
+}
@@ -3,11 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
@@ -19,6 +21,10 @@ var workspaceResourceAttrTypes = map[string]attr.Type{
 	"name":          types.StringType,
 	"owner_id":      types.StringType,
 	"shared":        types.BoolType,
+
+	"creator_user_id":        types.StringType,
+	"anonymous_access_level": types.StringType,
+	"role_to_group_ids":      types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
 }
 
 var workspaceResourceObjectType = types.ObjectType{AttrTypes: workspaceResourceAttrTypes}
@@ -32,7 +38,10 @@ type WorkspaceResourcesDataSource struct {
 }
 
 type workspaceResourcesDataSourceModel struct {
-	Resources types.List `tfsdk:"resources"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	OwnerID      types.String `tfsdk:"owner_id"`
+	SharedWith   types.String `tfsdk:"shared_with"`
+	Resources    types.List   `tfsdk:"resources"`
 }
 
 func (d *WorkspaceResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,12 +50,24 @@ func (d *WorkspaceResourcesDataSource) Metadata(ctx context.Context, req datasou
 
 func (d *WorkspaceResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetches workspace resources.",
+		MarkdownDescription: "Fetches workspace resources and who they are shared with.",
 		Attributes: map[string]schema.Attribute{
+			"resource_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return resources of this type, e.g. `convai_agents`.",
+			},
+			"owner_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return resources owned by this user ID.",
+			},
+			"shared_with": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return resources shared with this principal: a workspace member email, a user ID, a group ID, a workspace API key ID or `default`.",
+			},
 			"resources": schema.ListAttribute{
 				Computed:            true,
 				ElementType:         workspaceResourceObjectType,
-				MarkdownDescription: "List of workspace resources.",
+				MarkdownDescription: "List of workspace resources. `role_to_group_ids` maps each role to the principals holding it; users are listed by user ID.",
 			},
 		},
 	}
@@ -71,6 +92,10 @@ func (d *WorkspaceResourcesDataSource) Configure(ctx context.Context, req dataso
 
 func (d *WorkspaceResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data workspaceResourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resources, err := d.client.GetWorkspaceResources()
 	if err != nil {
@@ -78,7 +103,29 @@ func (d *WorkspaceResourcesDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	resourcesList, diags := flattenWorkspaceResources(ctx, resources)
+	sharedWith := data.SharedWith.ValueString()
+	if strings.Contains(sharedWith, "@") {
+		userIDs, _, err := workspaceMemberUserIDs(d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading workspace members", err.Error())
+			return
+		}
+		userID, ok := userIDs[strings.ToLower(sharedWith)]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("shared_with"), "Workspace Member Not Found",
+				fmt.Sprintf("No workspace member with email %q exists.", sharedWith))
+			return
+		}
+		sharedWith = userID
+	}
+
+	filtered, err := filterWorkspaceResources(d.client, resources, data.ResourceType.ValueString(), data.OwnerID.ValueString(), sharedWith)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching workspace resource", err.Error())
+		return
+	}
+
+	resourcesList, diags := flattenWorkspaceResources(ctx, filtered)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -89,6 +136,41 @@ func (d *WorkspaceResourcesDataSource) Read(ctx context.Context, req datasource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// filterWorkspaceResources returns the resources matching resourceType,
+// ownerID and sharedWith, each filter applying only when set. The listing does
+// not include role mappings, so they are read for every resource that passes
+// the type and owner filters, both to match sharedWith and for the output.
+func filterWorkspaceResources(c *client.Client, resources []models.WorkspaceResource, resourceType, ownerID, sharedWith string) ([]models.WorkspaceResource, error) {
+	filtered := make([]models.WorkspaceResource, 0, len(resources))
+	for _, resource := range resources {
+		if resourceType != "" && resource.ResourceType != resourceType {
+			continue
+		}
+		if ownerID != "" && resource.OwnerID != ownerID && resource.CreatorUserID != ownerID {
+			continue
+		}
+
+		if resource.RoleToGroupIDs == nil {
+			details, err := c.GetWorkspaceResource(resource.ResourceID, resource.ResourceType)
+			if err != nil {
+				return nil, fmt.Errorf("could not read %s %s: %w", resource.ResourceType, resource.ResourceID, err)
+			}
+			resource.CreatorUserID = details.CreatorUserID
+			resource.AnonymousAccessLevelOverride = details.AnonymousAccessLevelOverride
+			resource.RoleToGroupIDs = details.RoleToGroupIDs
+		}
+
+		if sharedWith != "" {
+			if _, ok := resourcePrincipalRoles(&resource)[sharedWith]; !ok {
+				continue
+			}
+		}
+		filtered = append(filtered, resource)
+	}
+
+	return filtered, nil
+}
+
 func flattenWorkspaceResources(ctx context.Context, resources []models.WorkspaceResource) (types.List, diag.Diagnostics) {
 	if len(resources) == 0 {
 		return types.ListNull(workspaceResourceObjectType), nil
@@ -96,12 +178,26 @@ func flattenWorkspaceResources(ctx context.Context, resources []models.Workspace
 
 	values := make([]attr.Value, 0, len(resources))
 	for _, resource := range resources {
+		roleToGroupIDs, mapDiags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, resource.RoleToGroupIDs)
+		if mapDiags.HasError() {
+			return types.ListNull(workspaceResourceObjectType), mapDiags
+		}
+
+		anonymousAccessLevel := types.StringNull()
+		if resource.AnonymousAccessLevelOverride != nil {
+			anonymousAccessLevel = types.StringValue(*resource.AnonymousAccessLevelOverride)
+		}
+
 		obj, objDiags := types.ObjectValue(workspaceResourceAttrTypes, map[string]attr.Value{
 			"resource_id":   types.StringValue(resource.ResourceID),
 			"resource_type": types.StringValue(resource.ResourceType),
 			"name":          types.StringValue(resource.Name),
 			"owner_id":      types.StringValue(resource.OwnerID),
-			"shared":        types.BoolValue(resource.Shared),
+			"shared":        types.BoolValue(resource.Shared || len(resourcePrincipalRoles(&resource)) > 0),
+
+			"creator_user_id":        optionalStringValue(resource.CreatorUserID),
+			"anonymous_access_level": anonymousAccessLevel,
+			"role_to_group_ids":      roleToGroupIDs,
 		})
 		if objDiags.HasError() {
 			return types.ListNull(workspaceResourceObjectType), objDiags
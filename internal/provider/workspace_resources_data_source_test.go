@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+func TestAccWorkspaceResourcesDataSource(t *testing.T) {
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/resources",
+			Body: `[
+{"resource_id":"agent-1","resource_type":"convai_agents","name":"Support Agent","owner_id":"user-owner"},
+{"resource_id":"agent-2","resource_type":"convai_agents","name":"Sales Agent","owner_id":"user-other"},
+{"resource_id":"voice-1","resource_type":"voice","name":"Narrator","owner_id":"user-owner"}
+]`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/resources/agent-1",
+			Body:   `{"resource_id":"agent-1","resource_type":"convai_agents","creator_user_id":"user-owner","anonymous_access_level_override":null,"role_to_group_ids":{"admin":["user-owner"],"viewer":["group-contractors","user-alice"]},"share_options":[]}`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/resources/agent-2",
+			Body:   `{"resource_id":"agent-2","resource_type":"convai_agents","creator_user_id":"user-other","anonymous_access_level_override":"viewer","role_to_group_ids":{"admin":["user-other"]},"share_options":[]}`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/resources/voice-1",
+			Body:   `{"resource_id":"voice-1","resource_type":"voice","creator_user_id":"user-owner","anonymous_access_level_override":null,"role_to_group_ids":{"admin":["user-owner"],"editor":["group-contractors"]},"share_options":[]}`,
+		},
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/members",
+			Body:   `[{"user_id":"user-alice","email":"alice@example.com"}]`,
+		},
+	})
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "elevenlabs_workspace_resources" "all" {}
+
+data "elevenlabs_workspace_resources" "contractor_agents" {
+  resource_type = "convai_agents"
+  shared_with   = "group-contractors"
+}
+
+data "elevenlabs_workspace_resources" "alice" {
+  shared_with = "alice@example.com"
+}
+
+data "elevenlabs_workspace_resources" "owned" {
+  owner_id = "user-other"
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_resources.all", "resources.#", "3"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_resources.all", "resources.0.shared", "true"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_resources.all", "resources.0.role_to_group_ids.viewer.#", "2"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_resources.all", "resources.1.shared", "false"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_resources.all", "resources.1.anonymous_access_level", "viewer"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_resources.contractor_agents", "resources.#", "1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_resources.contractor_agents", "resources.0.resource_id", "agent-1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_resources.alice", "resources.#", "1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_resources.owned", "resources.#", "1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_resources.owned", "resources.0.creator_user_id", "user-other"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterWorkspaceResources_FetchesOnlyMatches(t *testing.T) {
+	var mu sync.Mutex
+	var fetched []string
+	details := func(id string) testRoute {
+		return testRoute{
+			Method: httpMethodGet,
+			Path:   "/workspace/resources/" + id,
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				fetched = append(fetched, id)
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"resource_id":%q,"role_to_group_ids":{"viewer":["group-contractors"]}}`, id)
+			},
+		}
+	}
+
+	server := newTestServer(t, []testRoute{details("agent-1"), details("agent-2"), details("voice-1")})
+	defer server.Close()
+
+	resources := []models.WorkspaceResource{
+		{ResourceID: "agent-1", ResourceType: "convai_agents", OwnerID: "user-owner"},
+		{ResourceID: "agent-2", ResourceType: "convai_agents", OwnerID: "user-other"},
+		{ResourceID: "voice-1", ResourceType: "voice", OwnerID: "user-owner"},
+	}
+
+	c := client.NewClient("test-key", server.URL)
+	filtered, err := filterWorkspaceResources(c, resources, "convai_agents", "user-owner", "group-contractors")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(filtered) != 1 || filtered[0].ResourceID != "agent-1" {
+		t.Errorf("Expected only agent-1, got %v", filtered)
+	}
+
+	sort.Strings(fetched)
+	if got := strings.Join(fetched, ","); got != "agent-1" {
+		t.Errorf("Expected details to be fetched for agent-1 only, got %q", got)
+	}
+}
@@ -43,7 +43,7 @@ func TestAccWorkspaceAndSharingResources(t *testing.T) {
 		{
 			Method: http.MethodGet,
 			Path:   "/workspace/webhooks",
-			Body:   `[{"webhook_id":"webhook-123","url":"https://example.com","events":["voice_created"],"secret":"secret-123"}]`,
+			Body:   `{"webhooks":[{"webhook_id":"webhook-123","name":"https://example.com","webhook_url":"https://example.com","auth_type":"hmac","is_disabled":false,"is_auto_disabled":false,"created_at_unix":1700000000}]}`,
 		},
 		{
 			Method: http.MethodPost,
@@ -70,7 +70,7 @@ func TestAccWorkspaceAndSharingResources(t *testing.T) {
 		{
 			Method: http.MethodGet,
 			Path:   "/workspace/groups/search",
-			Body:   `[{"group_id":"group-123","name":"Test Group"}]`,
+			Body:   `[{"id":"group-123","name":"Test Group","members_emails":["group@example.com"]}]`,
 		},
 		{
 			Method: http.MethodPost,
@@ -85,6 +85,11 @@ func TestAccWorkspaceAndSharingResources(t *testing.T) {
 			Path:   "/service-accounts",
 			Body:   `[{"service_account_user_id":"sa-123","name":"Service Account"}]`,
 		},
+		{
+			Method: http.MethodGet,
+			Path:   "/service-accounts/sa-123/api-keys",
+			Body:   `{"api-keys":[{"key_id":"key-123","name":"Key","hint":"sk_1","service_account_user_id":"sa-123","permissions":["voice.read"],"character_limit":1000}]}`,
+		},
 		{
 			Method: http.MethodPost,
 			Path:   "/service-accounts/sa-123/api-keys",
@@ -99,6 +104,11 @@ func TestAccWorkspaceAndSharingResources(t *testing.T) {
 			Path:   "/workspace/resources",
 			Body:   `[{"resource_id":"resource-123","resource_type":"voice","name":"Shared Voice","owner_id":"owner-1","shared":true}]`,
 		},
+		{
+			Method: http.MethodGet,
+			Path:   "/workspace/resources/resource-123",
+			Body:   `{"resource_id":"resource-123","resource_type":"voice","creator_user_id":"owner-1","role_to_group_ids":{"admin":["owner-1"]},"share_options":[]}`,
+		},
 		{
 			Method: http.MethodPost,
 			Path:   "/workspace/resources/resource-123/share",
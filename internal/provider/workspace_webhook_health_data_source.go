@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &WorkspaceWebhookHealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &WorkspaceWebhookHealthDataSource{}
+)
+
+func NewWorkspaceWebhookHealthDataSource() datasource.DataSource {
+	return &WorkspaceWebhookHealthDataSource{}
+}
+
+type WorkspaceWebhookHealthDataSource struct {
+	client *client.Client
+}
+
+type WorkspaceWebhookHealthDataSourceModel struct {
+	WebhookID              types.String                  `tfsdk:"webhook_id"`
+	AutoDisabledWebhookIDs []types.String                `tfsdk:"auto_disabled_webhook_ids"`
+	Webhooks               []WorkspaceWebhookHealthModel `tfsdk:"webhooks"`
+}
+
+type WorkspaceWebhookHealthModel struct {
+	WebhookID                  types.String   `tfsdk:"webhook_id"`
+	Name                       types.String   `tfsdk:"name"`
+	URL                        types.String   `tfsdk:"url"`
+	AuthType                   types.String   `tfsdk:"auth_type"`
+	IsDisabled                 types.Bool     `tfsdk:"is_disabled"`
+	IsAutoDisabled             types.Bool     `tfsdk:"is_auto_disabled"`
+	Healthy                    types.Bool     `tfsdk:"healthy"`
+	Usage                      []types.String `tfsdk:"usage"`
+	MostRecentFailureErrorCode types.Int64    `tfsdk:"most_recent_failure_error_code"`
+	MostRecentFailureAt        types.String   `tfsdk:"most_recent_failure_at"`
+}
+
+func (d *WorkspaceWebhookHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_webhook_health"
+}
+
+func (d *WorkspaceWebhookHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the delivery health of workspace webhooks, including webhooks that ElevenLabs disabled after repeated failures.",
+		Attributes: map[string]schema.Attribute{
+			"webhook_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only report this webhook.",
+			},
+			"auto_disabled_webhook_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the webhooks that were disabled automatically.",
+			},
+			"webhooks": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"webhook_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"url": schema.StringAttribute{
+							Computed: true,
+						},
+						"auth_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_disabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the webhook was disabled by a user.",
+						},
+						"is_auto_disabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether ElevenLabs disabled the webhook after repeated failures.",
+						},
+						"healthy": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the webhook is enabled and not auto-disabled.",
+						},
+						"usage": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Products currently configured to trigger the webhook.",
+						},
+						"most_recent_failure_error_code": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Status code of the most recent failed delivery.",
+						},
+						"most_recent_failure_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Time of the most recent failed delivery, in RFC 3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorkspaceWebhookHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkspaceWebhookHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkspaceWebhookHealthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhooks, err := d.client.ListWorkspaceWebhooks(true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching workspace webhooks", err.Error())
+		return
+	}
+
+	data.AutoDisabledWebhookIDs = []types.String{}
+	data.Webhooks = []WorkspaceWebhookHealthModel{}
+	for _, webhook := range webhooks {
+		if !data.WebhookID.IsNull() && webhook.WebhookID != data.WebhookID.ValueString() {
+			continue
+		}
+
+		health := WorkspaceWebhookHealthModel{
+			WebhookID:                  types.StringValue(webhook.WebhookID),
+			Name:                       optionalStringValue(webhook.Name),
+			URL:                        types.StringValue(workspaceWebhookURL(webhook)),
+			AuthType:                   optionalStringValue(webhook.AuthType),
+			IsDisabled:                 types.BoolValue(webhook.IsDisabled),
+			IsAutoDisabled:             types.BoolValue(webhook.IsAutoDisabled),
+			Healthy:                    types.BoolValue(!webhook.IsDisabled && !webhook.IsAutoDisabled),
+			Usage:                      []types.String{},
+			MostRecentFailureErrorCode: types.Int64Null(),
+			MostRecentFailureAt:        types.StringNull(),
+		}
+		for _, usage := range workspaceWebhookUsage(webhook) {
+			health.Usage = append(health.Usage, types.StringValue(usage))
+		}
+		if webhook.MostRecentFailureErrorCode != nil {
+			health.MostRecentFailureErrorCode = types.Int64Value(int64(*webhook.MostRecentFailureErrorCode))
+		}
+		if webhook.MostRecentFailureTimestamp != nil {
+			health.MostRecentFailureAt = types.StringValue(time.Unix(*webhook.MostRecentFailureTimestamp, 0).UTC().Format(time.RFC3339))
+		}
+
+		if webhook.IsAutoDisabled {
+			data.AutoDisabledWebhookIDs = append(data.AutoDisabledWebhookIDs, health.WebhookID)
+		}
+		data.Webhooks = append(data.Webhooks, health)
+	}
+
+	if !data.WebhookID.IsNull() && len(data.Webhooks) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("webhook_id"), "Webhook Not Found",
+			fmt.Sprintf("No workspace webhook with ID %q exists.", data.WebhookID.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
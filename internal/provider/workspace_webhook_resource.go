@@ -3,11 +3,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/client"
@@ -15,11 +21,19 @@ import (
 )
 
 var (
-	_ resource.Resource                = &WorkspaceWebhookResource{}
-	_ resource.ResourceWithConfigure   = &WorkspaceWebhookResource{}
-	_ resource.ResourceWithImportState = &WorkspaceWebhookResource{}
+	_ resource.Resource                   = &WorkspaceWebhookResource{}
+	_ resource.ResourceWithConfigure      = &WorkspaceWebhookResource{}
+	_ resource.ResourceWithImportState    = &WorkspaceWebhookResource{}
+	_ resource.ResourceWithValidateConfig = &WorkspaceWebhookResource{}
 )
 
+// workspaceWebhookEventTypes are the values of the WebhookEventType enum in
+// the ElevenLabs API spec. New event types may be added before this list is
+// updated, so other values only produce a warning.
+var workspaceWebhookEventTypes = []string{"transcript", "audio", "call_initiation_failure"}
+
+const workspaceWebhookAuthTypeHMAC = "hmac"
+
 func NewWorkspaceWebhookResource() resource.Resource {
 	return &WorkspaceWebhookResource{}
 }
@@ -29,10 +43,18 @@ type WorkspaceWebhookResource struct {
 }
 
 type WorkspaceWebhookResourceModel struct {
-	ID     types.String `tfsdk:"id"`
-	URL    types.String `tfsdk:"url"`
-	Events types.List   `tfsdk:"events"`
-	Secret types.String `tfsdk:"secret"`
+	ID                         types.String `tfsdk:"id"`
+	Name                       types.String `tfsdk:"name"`
+	URL                        types.String `tfsdk:"url"`
+	Events                     types.List   `tfsdk:"events"`
+	AuthType                   types.String `tfsdk:"auth_type"`
+	IsDisabled                 types.Bool   `tfsdk:"is_disabled"`
+	Secret                     types.String `tfsdk:"secret"`
+	IsAutoDisabled             types.Bool   `tfsdk:"is_auto_disabled"`
+	Usage                      types.List   `tfsdk:"usage"`
+	MostRecentFailureErrorCode types.Int64  `tfsdk:"most_recent_failure_error_code"`
+	MostRecentFailureAt        types.String `tfsdk:"most_recent_failure_at"`
+	Workspace                  types.String `tfsdk:"workspace"`
 }
 
 func (r *WorkspaceWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,17 +71,64 @@ func (r *WorkspaceWebhookResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Display name of the webhook. Defaults to `url`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"url": schema.StringAttribute{
 				Required: true,
 			},
 			"events": schema.ListAttribute{
 				ElementType: types.StringType,
 				Required:    true,
+				MarkdownDescription: "Event types delivered to the webhook: " +
+					"`" + strings.Join(workspaceWebhookEventTypes, "`, `") + "`.",
+			},
+			"auth_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(workspaceWebhookAuthTypeHMAC),
+				MarkdownDescription: "How requests to the webhook are authenticated. Only `hmac` can be created through the API. Changing this forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_disabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the webhook is disabled. Defaults to `false`.",
 			},
 			"secret": schema.StringAttribute{
-				Computed:  true,
-				Sensitive: true,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "HMAC secret used to sign webhook requests.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_auto_disabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether ElevenLabs disabled the webhook after repeated failures.",
+			},
+			"usage": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Products currently configured to trigger the webhook.",
 			},
+			"most_recent_failure_error_code": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Status code of the most recent failed delivery.",
+			},
+			"most_recent_failure_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the most recent failed delivery, in RFC 3339 format.",
+			},
+			"workspace": workspaceAttribute(),
 		},
 	}
 }
@@ -81,6 +150,31 @@ func (r *WorkspaceWebhookResource) Configure(ctx context.Context, req resource.C
 	r.client = client
 }
 
+func (r *WorkspaceWebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WorkspaceWebhookResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AuthType.IsNull() && !data.AuthType.IsUnknown() && data.AuthType.ValueString() != workspaceWebhookAuthTypeHMAC {
+		resp.Diagnostics.AddAttributeError(path.Root("auth_type"), "Invalid Configuration",
+			fmt.Sprintf("Only `hmac` webhooks can be created through the API, got %q.", data.AuthType.ValueString()))
+	}
+
+	if data.Events.IsNull() || data.Events.IsUnknown() {
+		return
+	}
+	for i, element := range data.Events.Elements() {
+		event, ok := element.(types.String)
+		if !ok || event.IsNull() || event.IsUnknown() || slices.Contains(workspaceWebhookEventTypes, event.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(path.Root("events").AtListIndex(i), "Unrecognized Webhook Event Type",
+			fmt.Sprintf("Webhook event type %q is not one of the known event types (%s). It is sent to the API as is.", event.ValueString(), strings.Join(workspaceWebhookEventTypes, ", ")))
+	}
+}
+
 func (r *WorkspaceWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data WorkspaceWebhookResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,25 +182,57 @@ func (r *WorkspaceWebhookResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var events []string
 	resp.Diagnostics.Append(data.Events.ElementsAs(ctx, &events, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if data.Name.IsUnknown() {
+		data.Name = data.URL
+	}
+
 	addReq := &models.CreateWorkspaceWebhookRequest{
 		URL:    data.URL.ValueString(),
 		Events: events,
+		Settings: &models.WorkspaceWebhookSettings{
+			AuthType:   data.AuthType.ValueString(),
+			Name:       data.Name.ValueString(),
+			WebhookURL: data.URL.ValueString(),
+		},
 	}
 
-	webhook, err := r.client.CreateWorkspaceWebhook(addReq)
+	webhook, err := c.CreateWorkspaceWebhook(addReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating workspace webhook", err.Error())
 		return
 	}
 
 	data.ID = types.StringValue(webhook.WebhookID)
-	data.Secret = types.StringValue(webhook.Secret)
+	data.Secret = types.StringValue(workspaceWebhookSecret(*webhook))
+
+	// New webhooks are always enabled, so a disabled webhook needs a follow-up update.
+	if data.IsDisabled.ValueBool() {
+		err := c.UpdateWorkspaceWebhook(webhook.WebhookID, &models.UpdateWorkspaceWebhookRequest{
+			Name:       data.Name.ValueString(),
+			IsDisabled: true,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error disabling workspace webhook", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(setWorkspaceWebhookHealth(ctx, &data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -118,20 +244,42 @@ func (r *WorkspaceWebhookResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	webhook, err := r.client.GetWorkspaceWebhook(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := c.GetWorkspaceWebhook(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading workspace webhook", err.Error())
 		return
 	}
 
-	data.URL = types.StringValue(webhook.URL)
+	data.URL = types.StringValue(workspaceWebhookURL(*webhook))
 
 	events, diag := types.ListValueFrom(ctx, types.StringType, webhook.Events)
 	resp.Diagnostics.Append(diag...)
 	data.Events = events
 
-	if webhook.Secret != "" {
-		data.Secret = types.StringValue(webhook.Secret)
+	if secret := workspaceWebhookSecret(*webhook); secret != "" {
+		data.Secret = types.StringValue(secret)
+	}
+	if webhook.Name != "" {
+		data.Name = types.StringValue(webhook.Name)
+	} else if data.Name.IsNull() {
+		data.Name = data.URL
+	}
+	if webhook.AuthType != "" {
+		data.AuthType = types.StringValue(webhook.AuthType)
+	} else if data.AuthType.IsNull() {
+		data.AuthType = types.StringValue(workspaceWebhookAuthTypeHMAC)
+	}
+	data.IsDisabled = types.BoolValue(webhook.IsDisabled)
+
+	resp.Diagnostics.Append(setWorkspaceWebhookHealth(ctx, &data, webhook)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -144,23 +292,46 @@ func (r *WorkspaceWebhookResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var events []string
 	resp.Diagnostics.Append(data.Events.ElementsAs(ctx, &events, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateReq := &models.CreateWorkspaceWebhookRequest{
-		URL:    data.URL.ValueString(),
-		Events: events,
+	if data.Name.IsUnknown() {
+		data.Name = data.URL
+	}
+
+	updateReq := &models.UpdateWorkspaceWebhookRequest{
+		URL:        data.URL.ValueString(),
+		Events:     events,
+		Name:       data.Name.ValueString(),
+		IsDisabled: data.IsDisabled.ValueBool(),
 	}
 
-	err := r.client.UpdateWorkspaceWebhook(data.ID.ValueString(), updateReq)
+	err := c.UpdateWorkspaceWebhook(data.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating workspace webhook", err.Error())
 		return
 	}
 
+	webhook, err := c.GetWorkspaceWebhook(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setWorkspaceWebhookHealth(ctx, &data, webhook)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -171,7 +342,13 @@ func (r *WorkspaceWebhookResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	err := r.client.DeleteWorkspaceWebhook(data.ID.ValueString())
+	c, diags := clientForWorkspace(r.client, data.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.DeleteWorkspaceWebhook(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting workspace webhook", err.Error())
 		return
@@ -179,5 +356,51 @@ func (r *WorkspaceWebhookResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *WorkspaceWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughWorkspaceID(ctx, path.Root("id"), req, resp)
+}
+
+// setWorkspaceWebhookHealth records the delivery health reported by the API.
+// A nil webhook clears the values, as for a newly created webhook.
+func setWorkspaceWebhookHealth(ctx context.Context, data *WorkspaceWebhookResourceModel, webhook *models.WorkspaceWebhook) diag.Diagnostics {
+	if webhook == nil {
+		webhook = &models.WorkspaceWebhook{}
+	}
+
+	data.IsAutoDisabled = types.BoolValue(webhook.IsAutoDisabled)
+	data.MostRecentFailureErrorCode = types.Int64Null()
+	if webhook.MostRecentFailureErrorCode != nil {
+		data.MostRecentFailureErrorCode = types.Int64Value(int64(*webhook.MostRecentFailureErrorCode))
+	}
+	data.MostRecentFailureAt = types.StringNull()
+	if webhook.MostRecentFailureTimestamp != nil {
+		data.MostRecentFailureAt = types.StringValue(time.Unix(*webhook.MostRecentFailureTimestamp, 0).UTC().Format(time.RFC3339))
+	}
+
+	usage, diags := types.ListValueFrom(ctx, types.StringType, workspaceWebhookUsage(*webhook))
+	data.Usage = usage
+	return diags
+}
+
+func workspaceWebhookUsage(webhook models.WorkspaceWebhook) []string {
+	usage := make([]string, len(webhook.Usage))
+	for i, u := range webhook.Usage {
+		usage[i] = u.UsageType
+	}
+	return usage
+}
+
+// workspaceWebhookURL returns the callback URL from either the settings-based
+// or the legacy response field.
+func workspaceWebhookURL(webhook models.WorkspaceWebhook) string {
+	if webhook.WebhookURL != "" {
+		return webhook.WebhookURL
+	}
+	return webhook.URL
+}
+
+func workspaceWebhookSecret(webhook models.WorkspaceWebhook) string {
+	if webhook.WebhookSecret != "" {
+		return webhook.WebhookSecret
+	}
+	return webhook.Secret
 }
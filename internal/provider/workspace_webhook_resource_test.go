@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/j4ng5y/terraform-provider-elevenlabs/internal/models"
+)
+
+func TestAccWorkspaceWebhookResourceDisable(t *testing.T) {
+	var mu sync.Mutex
+	webhook := models.WorkspaceWebhook{
+		WebhookID: "webhook-123",
+		Name:      "Transcripts",
+		URL:       "https://example.com/hook",
+		Events:    []string{"transcript"},
+		AuthType:  "hmac",
+	}
+
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodPost,
+			Path:   "/workspace/webhooks",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"webhook_id":"webhook-123","webhook_secret":"secret-123"}`))
+			},
+		},
+		{
+			// The secret is only returned on create.
+			Method: httpMethodGet,
+			Path:   "/workspace/webhooks/webhook-123",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(webhook)
+			},
+		},
+		{
+			Method: httpMethodPatch,
+			Path:   "/workspace/webhooks/webhook-123",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var body models.UpdateWorkspaceWebhookRequest
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				mu.Lock()
+				webhook.IsDisabled = body.IsDisabled
+				mu.Unlock()
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+		{
+			Method: httpMethodDelete,
+			Path:   "/workspace/webhooks/webhook-123",
+		},
+	})
+	defer server.Close()
+
+	config := func(disabled bool) string {
+		return fmt.Sprintf(`
+%s
+
+resource "elevenlabs_workspace_webhook" "test" {
+  name        = "Transcripts"
+  url         = "https://example.com/hook"
+  events      = ["transcript"]
+  is_disabled = %t
+}
+`, testAccProviderConfig(server.URL), disabled)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_workspace_webhook.test", "secret", "secret-123"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_webhook.test", "is_disabled", "false"),
+				),
+			},
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("elevenlabs_workspace_webhook.test", "secret", "secret-123"),
+					resource.TestCheckResourceAttr("elevenlabs_workspace_webhook.test", "is_disabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkspaceWebhookHealthDataSource(t *testing.T) {
+	server := newTestServer(t, []testRoute{
+		{
+			Method: httpMethodGet,
+			Path:   "/workspace/webhooks",
+			Body: `{"webhooks":[
+{"webhook_id":"webhook-ok","name":"Transcripts","webhook_url":"https://example.com/ok","auth_type":"hmac","is_disabled":false,"is_auto_disabled":false,"created_at_unix":1700000000,"usage":[{"usage_type":"ConvAI Settings"}]},
+{"webhook_id":"webhook-broken","name":"Audio","webhook_url":"https://example.com/broken","auth_type":"hmac","is_disabled":false,"is_auto_disabled":true,"created_at_unix":1700000000,"most_recent_failure_error_code":503,"most_recent_failure_timestamp":1700003600}
+]}`,
+		},
+	})
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "elevenlabs_workspace_webhook_health" "all" {}
+
+data "elevenlabs_workspace_webhook_health" "broken" {
+  webhook_id = "webhook-broken"
+}
+`, testAccProviderConfig(server.URL)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_webhook_health.all", "webhooks.#", "2"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_webhook_health.all", "webhooks.0.healthy", "true"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_webhook_health.all", "webhooks.0.usage.0", "ConvAI Settings"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_webhook_health.all", "auto_disabled_webhook_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_webhook_health.all", "auto_disabled_webhook_ids.0", "webhook-broken"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_webhook_health.broken", "webhooks.#", "1"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_webhook_health.broken", "webhooks.0.healthy", "false"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_webhook_health.broken", "webhooks.0.most_recent_failure_error_code", "503"),
+					resource.TestCheckResourceAttr("data.elevenlabs_workspace_webhook_health.broken", "webhooks.0.most_recent_failure_at", "2023-11-14T23:13:20Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkspaceWebhookResource_ValidateConfig(t *testing.T) {
+	r := NewWorkspaceWebhookResource()
+	events := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, len(values))
+		for i, value := range values {
+			elements[i] = tftypes.NewValue(tftypes.String, value)
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	}
+
+	diags := validateResourceConfig(t, r, map[string]tftypes.Value{
+		"events": events("transcript", "call_initiation_failure"),
+	})
+	if len(diags) != 0 {
+		t.Errorf("Expected known events to be accepted, got: %v", diags)
+	}
+
+	diags = validateResourceConfig(t, r, map[string]tftypes.Value{
+		"events": events("transcript", "voice_created"),
+	})
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("Expected a single warning for an unrecognized event type, got: %v", diags)
+	}
+
+	diags = validateResourceConfig(t, r, map[string]tftypes.Value{
+		"events":    events("transcript"),
+		"auth_type": tftypes.NewValue(tftypes.String, "oauth2"),
+	})
+	if !diags.HasError() {
+		t.Errorf("Expected an error for a non-hmac auth_type")
+	}
+}
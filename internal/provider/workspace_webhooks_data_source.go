@@ -71,7 +71,7 @@ func (d *WorkspaceWebhooksDataSource) Configure(ctx context.Context, req datasou
 func (d *WorkspaceWebhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data workspaceWebhooksDataSourceModel
 
-	webhooks, err := d.client.ListWorkspaceWebhooks()
+	webhooks, err := d.client.ListWorkspaceWebhooks(false)
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching workspace webhooks", err.Error())
 		return
@@ -102,9 +102,9 @@ func flattenWorkspaceWebhooks(ctx context.Context, webhooks []models.WorkspaceWe
 
 		obj, objDiags := types.ObjectValue(workspaceWebhookAttrTypes, map[string]attr.Value{
 			"webhook_id": types.StringValue(webhook.WebhookID),
-			"url":        types.StringValue(webhook.URL),
+			"url":        types.StringValue(workspaceWebhookURL(webhook)),
 			"events":     events,
-			"secret":     optionalStringValue(webhook.Secret),
+			"secret":     optionalStringValue(workspaceWebhookSecret(webhook)),
 		})
 		if objDiags.HasError() {
 			return types.ListNull(workspaceWebhookObjectType), objDiags